@@ -0,0 +1,257 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+const (
+	auditSourceCLI  = "cli"
+	auditSourceBulk = "bulk"
+	auditSourceTUI  = "tui"
+	auditSourceUndo = "undo"
+)
+
+// auditMu serialises appends within this process; appendAuditEntry also
+// locks the file itself so separate CLI runs get distinct entry numbers.
+var auditMu sync.Mutex
+
+// auditTail caches where the log ended after this process last appended to
+// it, so later appends only read lines other processes have added since.
+// It is guarded by auditMu.
+var auditTail struct {
+	path  string
+	size  int64
+	entry int
+}
+
+// auditEntry is one line of the audit log. Entries are numbered from 1 in the
+// order they were written.
+type auditEntry struct {
+	Entry     int             `json:"entry"`
+	Timestamp time.Time       `json:"timestamp"`
+	Profile   string          `json:"profile,omitempty"`
+	Source    string          `json:"source"`
+	ItemID    int64           `json:"item_id"`
+	Before    *auditItemState `json:"before,omitempty"`
+	Body      map[string]any  `json:"body"`
+	After     *auditItemState `json:"after,omitempty"`
+	Undoes    int             `json:"undoes,omitempty"`
+}
+
+// auditItemState is the part of an item that undo can restore. Zero
+// assignment IDs mean the item was unassigned.
+type auditItemState struct {
+	Title          string `json:"title,omitempty"`
+	Level          string `json:"level,omitempty"`
+	Status         string `json:"status,omitempty"`
+	Environment    string `json:"environment,omitempty"`
+	AssignedUserID int64  `json:"assigned_user_id"`
+	AssignedTeamID int64  `json:"assigned_team_id"`
+}
+
+// auditLogPath returns $ROLLBAR_CLI_AUDIT_LOG, or audit.jsonl next to the
+// default config file.
+func auditLogPath() (string, error) {
+	if path := strings.TrimSpace(os.Getenv("ROLLBAR_CLI_AUDIT_LOG")); path != "" {
+		return filepath.Clean(path), nil
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "rollbar-cli", "audit.jsonl"), nil
+}
+
+func readAuditEntries() ([]auditEntry, error) {
+	path, err := auditLogPath()
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+	defer f.Close()
+
+	var entries []auditEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var entry auditEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, fmt.Errorf("parse audit log %s line %d: %w", path, lineNo, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+	return entries, nil
+}
+
+func appendAuditEntry(entry auditEntry) error {
+	auditMu.Lock()
+	defer auditMu.Unlock()
+
+	path, err := auditLogPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create audit log dir: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	defer f.Close()
+	if err := lockFile(f); err != nil {
+		return fmt.Errorf("lock audit log: %w", err)
+	}
+	defer unlockFile(f)
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("read audit log: %w", err)
+	}
+	offset, last := int64(0), 0
+	if auditTail.path == path && auditTail.size <= info.Size() {
+		offset, last = auditTail.size, auditTail.entry
+	}
+	if offset < info.Size() {
+		if last, err = lastAuditEntryNumber(io.NewSectionReader(f, offset, info.Size()-offset), last); err != nil {
+			return fmt.Errorf("read audit log: %w", err)
+		}
+	}
+	entry.Entry = last + 1
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if _, err := f.Write(data); err != nil {
+		auditTail.path = ""
+		return fmt.Errorf("write audit log: %w", err)
+	}
+	auditTail.path, auditTail.size, auditTail.entry = path, info.Size()+int64(len(data)), entry.Entry
+	return nil
+}
+
+// lastAuditEntryNumber returns the highest entry number in r, or last when r
+// holds no entries.
+func lastAuditEntryNumber(r io.Reader, last int) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var entry struct {
+			Entry int `json:"entry"`
+		}
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return 0, err
+		}
+		last = max(last, entry.Entry)
+	}
+	return last, scanner.Err()
+}
+
+// updateItemAudited sends body to item id and records the change in the
+// audit log. before is the item as fetched ahead of the update. Failing to
+// write the log only warns, since the update has already been applied.
+func updateItemAudited(cmd *cobra.Command, cfg *cliConfig, client *rollbar.Client, id int64, before *rollbar.GetItemResponse, body map[string]any, entry auditEntry) (*rollbar.UpdateItemResponse, error) {
+	resp, err := client.UpdateItemByID(cmd.Context(), id, body)
+	if err != nil {
+		return nil, err
+	}
+
+	after := auditStateFromItem(resp.Item)
+	if resp.Item.ID <= 0 {
+		after = nil
+		if getResp, err := client.GetItemByID(cmd.Context(), id); err == nil {
+			resp.Item = getResp.Item
+			after = auditStateFromItem(getResp.Item)
+		}
+	}
+
+	entry.Timestamp = time.Now().UTC()
+	entry.Profile = cfg.Profile
+	entry.ItemID = id
+	entry.Before = auditStateFromItem(before.Item)
+	entry.Body = body
+	entry.After = after
+	if err := appendAuditEntry(entry); err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "warning: item %d was updated but the audit log was not written: %v\n", id, err)
+	}
+	return resp, nil
+}
+
+func auditStateFromItem(item rollbar.Item) *auditItemState {
+	return &auditItemState{
+		Title:          item.Title,
+		Level:          item.Level,
+		Status:         item.Status,
+		Environment:    item.Environment,
+		AssignedUserID: item.AssignedUserID,
+		AssignedTeamID: item.AssignedTeamID,
+	}
+}
+
+// inverseItemUpdate builds the body that restores the fields entry changed.
+func inverseItemUpdate(entry auditEntry) (map[string]any, error) {
+	if entry.Before == nil {
+		return nil, fmt.Errorf("entry %d has no recorded before state to restore", entry.Entry)
+	}
+
+	inverse := make(map[string]any)
+	if _, ok := entry.Body["status"]; ok && entry.Before.Status != "" {
+		inverse["status"] = entry.Before.Status
+	}
+	if _, ok := entry.Body["level"]; ok && entry.Before.Level != "" {
+		inverse["level"] = entry.Before.Level
+	}
+	if _, ok := entry.Body["title"]; ok && entry.Before.Title != "" {
+		inverse["title"] = entry.Before.Title
+	}
+	if _, ok := entry.Body["assigned_user_id"]; ok {
+		inverse["assigned_user_id"] = nullableID(entry.Before.AssignedUserID)
+	}
+	if _, ok := entry.Body["assigned_team_id"]; ok {
+		inverse["assigned_team_id"] = nullableID(entry.Before.AssignedTeamID)
+	}
+	if len(inverse) == 0 {
+		return nil, fmt.Errorf("entry %d changed nothing undo can restore (status, level, title, or assignment)", entry.Entry)
+	}
+	return inverse, nil
+}
+
+func nullableID(id int64) any {
+	if id <= 0 {
+		return nil
+	}
+	return id
+}
@@ -0,0 +1,11 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd && !dragonfly && !windows
+
+package cmd
+
+import "os"
+
+// lockFile is a no-op on platforms without flock or LockFileEx; appends
+// are still serialised within the process by auditMu.
+func lockFile(*os.File) error { return nil }
+
+func unlockFile(*os.File) error { return nil }
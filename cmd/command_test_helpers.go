@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
 func runCLIWithCapturedStdout(t *testing.T, args ...string) (string, error) {
 	t.Helper()
+	if os.Getenv("ROLLBAR_CLI_AUDIT_LOG") == "" {
+		t.Setenv("ROLLBAR_CLI_AUDIT_LOG", filepath.Join(t.TempDir(), "audit.jsonl"))
+	}
 
 	oldStdout := os.Stdout
 	r, w, err := os.Pipe()
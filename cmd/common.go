@@ -1,15 +1,22 @@
 package cmd
 
 import (
+	"cmp"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
 
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
 	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
 )
 
@@ -20,15 +27,150 @@ type payloadOptions struct {
 	RedactKeys bool
 }
 
+type paginationOptions struct {
+	All      bool
+	MaxPages int
+}
+
 func newRollbarClient(cfg *cliConfig) *rollbar.Client {
 	return rollbar.NewClient(rollbar.Config{
 		AccessToken: cfg.Token,
 		BaseURL:     cfg.BaseURL,
 		Timeout:     cfg.Timeout,
+		Retry: rollbar.RetryConfig{
+			Disabled:    !cfg.Retries,
+			MaxAttempts: cfg.MaxAttempts,
+			MaxWait:     cfg.RetryMaxWait,
+		},
+		DryRun: cfg.DryRun,
 	})
 }
 
+func newRollbarAccountClient(cfg *cliConfig) *rollbar.Client {
+	accountCfg := *cfg
+	accountCfg.Token = cfg.AccountToken
+	return newRollbarClient(&accountCfg)
+}
+
+func addPaginationFlags(flags *pflag.FlagSet, opts *paginationOptions, defaultMaxPages int) {
+	flags.BoolVar(&opts.All, "all", false, "Fetch every page (same as --max-pages 0)")
+	flags.IntVar(&opts.MaxPages, "max-pages", defaultMaxPages, "Maximum number of pages to fetch; 0 fetches every page")
+}
+
+func (o paginationOptions) pagerOptions(startPage int) (rollbar.PagerOptions, error) {
+	if o.MaxPages < 0 {
+		return rollbar.PagerOptions{}, fmt.Errorf("--max-pages must be >= 0")
+	}
+	if startPage <= 0 {
+		startPage = 1
+	}
+	maxPages := o.MaxPages
+	if o.All {
+		maxPages = 0
+	}
+	return rollbar.PagerOptions{
+		StartPage: startPage,
+		MaxPages:  maxPages,
+		Workers:   rollbar.DefaultPageWorkers,
+	}, nil
+}
+
+func collectPages[T any](ctx context.Context, pager *rollbar.Pager[T], stopAt int) ([]T, []map[string]any, error) {
+	return collectFilteredPages(ctx, pager, nil, stopAt)
+}
+
+// collectFilteredPages drops items that fail keep (when set) as pages arrive,
+// so stopAt counts only the items that will be shown.
+func collectFilteredPages[T any](ctx context.Context, pager *rollbar.Pager[T], keep func(T) bool, stopAt int) ([]T, []map[string]any, error) {
+	items := make([]T, 0)
+	rawPages := make([]map[string]any, 0)
+	for {
+		page, ok, err := pager.Next(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			return items, rawPages, nil
+		}
+		for _, item := range page.Items {
+			if keep == nil || keep(item) {
+				items = append(items, item)
+			}
+		}
+		rawPages = append(rawPages, page.Raw)
+		if stopAt > 0 && len(items) >= stopAt {
+			return items, rawPages, nil
+		}
+	}
+}
+
+func rawPagesOutput(rawPages []map[string]any) any {
+	if len(rawPages) == 1 {
+		return rawPages[0]
+	}
+	return map[string]any{"pages": rawPages}
+}
+
+// identifierRef is what an identifier argument named. Counter is only set
+// for items, given as #1234 or as a Rollbar web URL, since the web app
+// addresses items by their project counter.
+type identifierRef struct {
+	ID      int64
+	UUID    string
+	Counter int64
+}
+
+func (r identifierRef) String() string {
+	switch {
+	case r.UUID != "":
+		return r.UUID
+	case r.Counter > 0:
+		return "#" + strconv.FormatInt(r.Counter, 10)
+	default:
+		return strconv.FormatInt(r.ID, 10)
+	}
+}
+
+// addItemCounterFlag adds the counter flag read by resolveItemIdentifier.
+func addItemCounterFlag(cmd *cobra.Command, name string) {
+	cmd.Flags().Int64(name, 0, "Item counter, the #1234 shown in the Rollbar UI")
+}
+
+// resolveItemIdentifier is resolveIdentifier for items, which can also be
+// named by their project counter. flagPrefix is "" for --id, --uuid, and
+// --counter, or "item-" for --item-id, --item-uuid, and --item-counter.
+func resolveItemIdentifier(cmd *cobra.Command, args []string, id int64, uuid string, flagPrefix string) (identifierRef, error) {
+	arg := ""
+	if len(args) > 0 {
+		arg = args[0]
+	}
+	positionalLabel := "[" + flagPrefix + "id-or-uuid]"
+	idLabel, uuidLabel, counterLabel := "--"+flagPrefix+"id", "--"+flagPrefix+"uuid", "--"+flagPrefix+"counter"
+	idSet := id > 0 || cmd.Flags().Changed(flagPrefix+"id")
+	uuidSet := uuid != "" || cmd.Flags().Changed(flagPrefix+"uuid")
+	if !cmd.Flags().Changed(flagPrefix + "counter") {
+		return resolveIdentifier(arg, id, uuid, idSet, uuidSet, "item", positionalLabel, idLabel, uuidLabel)
+	}
+
+	if strings.TrimSpace(arg) != "" || idSet || uuidSet {
+		return identifierRef{}, fmt.Errorf("provide only one item identifier: %s, %s, %s, or %s", positionalLabel, idLabel, uuidLabel, counterLabel)
+	}
+	counter, err := cmd.Flags().GetInt64(flagPrefix + "counter")
+	if err != nil {
+		return identifierRef{}, err
+	}
+	if counter <= 0 {
+		return identifierRef{}, fmt.Errorf("invalid item counter: must be > 0")
+	}
+	return identifierRef{Counter: counter}, nil
+}
+
 func resolveIdentifierValue(arg string, id int64, uuid string, idSet bool, uuidSet bool, kind string, positionalLabel string, idLabel string, uuidLabel string) (int64, string, error) {
+	ref, err := resolveIdentifier(arg, id, uuid, idSet, uuidSet, kind, positionalLabel, idLabel, uuidLabel)
+	return ref.ID, ref.UUID, err
+}
+
+func resolveIdentifier(arg string, id int64, uuid string, idSet bool, uuidSet bool, kind string, positionalLabel string, idLabel string, uuidLabel string) (identifierRef, error) {
 	arg = strings.TrimSpace(arg)
 	uuid = strings.TrimSpace(uuid)
 
@@ -44,34 +186,99 @@ func resolveIdentifierValue(arg string, id int64, uuid string, idSet bool, uuidS
 	}
 
 	if sources == 0 {
-		return 0, "", fmt.Errorf("missing %s identifier: pass %s, %s, or %s", kind, positionalLabel, idLabel, uuidLabel)
+		return identifierRef{}, fmt.Errorf("missing %s identifier: pass %s, %s, or %s", kind, positionalLabel, idLabel, uuidLabel)
 	}
 	if sources > 1 {
-		return 0, "", fmt.Errorf("provide only one %s identifier: %s, %s, or %s", kind, positionalLabel, idLabel, uuidLabel)
+		return identifierRef{}, fmt.Errorf("provide only one %s identifier: %s, %s, or %s", kind, positionalLabel, idLabel, uuidLabel)
 	}
 
 	if arg != "" {
-		if isIntegerToken(arg) {
-			n, err := strconv.ParseInt(arg, 10, 64)
-			if err != nil || n <= 0 {
-				return 0, "", fmt.Errorf("invalid %s id %q: must be > 0", kind, arg)
-			}
-			return n, "", nil
-		}
-		return 0, arg, nil
+		return parseIdentifierArg(arg, kind)
 	}
 
 	if idSet {
 		if id <= 0 {
-			return 0, "", fmt.Errorf("invalid %s id: must be > 0", kind)
+			return identifierRef{}, fmt.Errorf("invalid %s id: must be > 0", kind)
 		}
-		return id, "", nil
+		return identifierRef{ID: id}, nil
 	}
 
 	if uuid == "" {
-		return 0, "", fmt.Errorf("invalid %s UUID: must not be empty", kind)
+		return identifierRef{}, fmt.Errorf("invalid %s UUID: must not be empty", kind)
+	}
+	return identifierRef{UUID: uuid}, nil
+}
+
+// parseIdentifierArg reads a positional identifier: a numeric ID, #counter
+// for items, a Rollbar web URL, or otherwise a UUID.
+func parseIdentifierArg(arg string, kind string) (identifierRef, error) {
+	switch {
+	case strings.HasPrefix(arg, "#"):
+		if kind != "item" {
+			return identifierRef{}, fmt.Errorf("invalid %s identifier %q: only items have #counters", kind, arg)
+		}
+		counter, err := strconv.ParseInt(arg[1:], 10, 64)
+		if err != nil || counter <= 0 {
+			return identifierRef{}, fmt.Errorf("invalid item counter %q: must be # followed by a number > 0", arg)
+		}
+		return identifierRef{Counter: counter}, nil
+	case isWebURL(arg):
+		return parseRollbarURL(arg, kind)
+	case isIntegerToken(arg):
+		n, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil || n <= 0 {
+			return identifierRef{}, fmt.Errorf("invalid %s id %q: must be > 0", kind, arg)
+		}
+		return identifierRef{ID: n}, nil
+	default:
+		return identifierRef{UUID: arg}, nil
+	}
+}
+
+// parseRollbarURL pulls an identifier out of a Rollbar web link such as
+// https://rollbar.com/acme/api/items/1234/occurrences/567/ or
+// https://rollbar.com/item/uuid/?uuid=.... Item links carry the counter.
+func parseRollbarURL(raw string, kind string) (identifierRef, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return identifierRef{}, fmt.Errorf("invalid Rollbar URL %q: %w", raw, err)
+	}
+	segments := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+	if uuid := strings.TrimSpace(u.Query().Get("uuid")); uuid != "" && len(segments) > 0 && segments[0] == kind {
+		return identifierRef{UUID: uuid}, nil
+	}
+
+	collection := kind + "s"
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] != collection {
+			continue
+		}
+		n, err := strconv.ParseInt(segments[i+1], 10, 64)
+		if err != nil || n <= 0 {
+			break
+		}
+		if kind == "item" {
+			return identifierRef{Counter: n}, nil
+		}
+		return identifierRef{ID: n}, nil
+	}
+	return identifierRef{}, fmt.Errorf("no %s found in Rollbar URL %q", kind, raw)
+}
+
+func isWebURL(arg string) bool {
+	return strings.HasPrefix(arg, "https://") || strings.HasPrefix(arg, "http://")
+}
+
+// getItemByRef fetches the item a reference names, whichever form it took.
+func getItemByRef(ctx context.Context, client *rollbar.Client, ref identifierRef) (*rollbar.GetItemResponse, error) {
+	switch {
+	case ref.UUID != "":
+		return client.GetItemByUUID(ctx, ref.UUID)
+	case ref.Counter > 0:
+		return client.GetItemByCounter(ctx, ref.Counter)
+	default:
+		return client.GetItemByID(ctx, ref.ID)
 	}
-	return 0, uuid, nil
 }
 
 func isIntegerToken(v string) bool {
@@ -121,52 +328,102 @@ func parseTimeFilter(value string) (time.Time, error) {
 	return time.Time{}, fmt.Errorf("unsupported time value %q: use RFC3339, YYYY-MM-DD, or unix seconds", value)
 }
 
-func filterItems(items []rollbar.Item, since time.Time, until time.Time, limit int) []rollbar.Item {
-	filtered := make([]rollbar.Item, 0, len(items))
-	for _, item := range items {
-		ts := time.Unix(item.LastOccurrenceTimestamp, 0).UTC()
-		if !since.IsZero() && (item.LastOccurrenceTimestamp == 0 || ts.Before(since)) {
+func itemInTimeRange(item rollbar.Item, since time.Time, until time.Time) bool {
+	ts := time.Unix(item.LastOccurrenceTimestamp, 0).UTC()
+	if !since.IsZero() && (item.LastOccurrenceTimestamp == 0 || ts.Before(since)) {
+		return false
+	}
+	if !until.IsZero() && item.LastOccurrenceTimestamp > 0 && ts.After(until) {
+		return false
+	}
+	return true
+}
+
+type itemComparator func(a, b rollbar.Item) int
+
+// itemSortKeys is the registry behind --sort. Each comparator orders
+// ascending; parseItemSort reverses it for "-key" or "key_desc".
+var itemSortKeys = map[string]itemComparator{
+	"last_seen":         byItemNumber(func(item rollbar.Item) int64 { return item.LastOccurrenceTimestamp }),
+	"first_seen":        byItemNumber(func(item rollbar.Item) int64 { return item.FirstOccurrenceTimestamp }),
+	"counter":           byItemNumber(func(item rollbar.Item) int64 { return item.Counter }),
+	"id":                byItemNumber(func(item rollbar.Item) int64 { return item.ID }),
+	"total_occurrences": byItemNumber(func(item rollbar.Item) int64 { return item.TotalOccurrences }),
+	"level":             byItemNumber(func(item rollbar.Item) int64 { return rollbar.LevelRank(item.Level) }),
+	"title":             byItemText(func(item rollbar.Item) string { return item.Title }),
+	"environment":       byItemText(func(item rollbar.Item) string { return item.Environment }),
+	"status":            byItemText(func(item rollbar.Item) string { return item.Status }),
+}
+
+func byItemNumber(field func(rollbar.Item) int64) itemComparator {
+	return func(a, b rollbar.Item) int { return cmp.Compare(field(a), field(b)) }
+}
+
+func byItemText(field func(rollbar.Item) string) itemComparator {
+	return func(a, b rollbar.Item) int {
+		return strings.Compare(strings.ToLower(field(a)), strings.ToLower(field(b)))
+	}
+}
+
+var itemSortAliases = map[string]string{
+	"occurrences":                "total_occurrences",
+	"last_occurrence_timestamp":  "last_seen",
+	"first_occurrence_timestamp": "first_seen",
+}
+
+// parseItemSort turns a comma-separated list of sort keys into a single
+// comparator; later keys break ties in earlier ones. A key sorts ascending
+// unless it has a "-" prefix or a "_desc" suffix. An empty spec sorts by
+// most recently seen.
+func parseItemSort(spec string) (itemComparator, error) {
+	if strings.TrimSpace(spec) == "" {
+		spec = "-last_seen"
+	}
+	var comparators []itemComparator
+	for _, part := range strings.Split(spec, ",") {
+		key := strings.ToLower(strings.TrimSpace(part))
+		if key == "" {
 			continue
 		}
-		if !until.IsZero() && item.LastOccurrenceTimestamp > 0 && ts.After(until) {
-			continue
+		descending := false
+		switch {
+		case strings.HasPrefix(key, "-"):
+			key, descending = key[1:], true
+		case strings.HasSuffix(key, "_desc"):
+			key, descending = strings.TrimSuffix(key, "_desc"), true
+		case strings.HasSuffix(key, "_asc"):
+			key = strings.TrimSuffix(key, "_asc")
 		}
-		filtered = append(filtered, item)
-	}
-
-	if limit > 0 && len(filtered) > limit {
-		return filtered[:limit]
+		if alias, ok := itemSortAliases[key]; ok {
+			key = alias
+		}
+		compare, ok := itemSortKeys[key]
+		if !ok {
+			return nil, fmt.Errorf("invalid --sort key %q (expected: %s)", strings.TrimSpace(part), strings.Join(itemSortKeyNames(), "|"))
+		}
+		if descending {
+			asc := compare
+			compare = func(a, b rollbar.Item) int { return asc(b, a) }
+		}
+		comparators = append(comparators, compare)
 	}
-	return filtered
+	return func(a, b rollbar.Item) int {
+		for _, compare := range comparators {
+			if c := compare(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}, nil
 }
 
-func sortItems(items []rollbar.Item, sortBy string) {
-	switch sortBy {
-	case "", "last_seen":
-		sort.SliceStable(items, func(i, j int) bool {
-			return items[i].LastOccurrenceTimestamp > items[j].LastOccurrenceTimestamp
-		})
-	case "last_seen_asc":
-		sort.SliceStable(items, func(i, j int) bool {
-			return items[i].LastOccurrenceTimestamp < items[j].LastOccurrenceTimestamp
-		})
-	case "counter":
-		sort.SliceStable(items, func(i, j int) bool {
-			return items[i].Counter > items[j].Counter
-		})
-	case "counter_asc":
-		sort.SliceStable(items, func(i, j int) bool {
-			return items[i].Counter < items[j].Counter
-		})
-	case "title":
-		sort.SliceStable(items, func(i, j int) bool {
-			return strings.ToLower(items[i].Title) < strings.ToLower(items[j].Title)
-		})
-	case "level":
-		sort.SliceStable(items, func(i, j int) bool {
-			return strings.ToLower(items[i].Level) < strings.ToLower(items[j].Level)
-		})
+func itemSortKeyNames() []string {
+	names := make([]string, 0, len(itemSortKeys))
+	for name := range itemSortKeys {
+		names = append(names, name)
 	}
+	slices.Sort(names)
+	return names
 }
 
 func sortOccurrences(instances []rollbar.ItemInstance) {
@@ -343,3 +600,10 @@ func formatUnix(ts int64) string {
 	}
 	return time.Unix(ts, 0).UTC().Format(time.RFC3339)
 }
+
+func valueOrDash(s string) string {
+	if strings.TrimSpace(s) == "" {
+		return "-"
+	}
+	return s
+}
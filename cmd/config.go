@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -17,9 +18,15 @@ type fileConfig struct {
 }
 
 type fileProfile struct {
-	Token   string `json:"token"`
-	BaseURL string `json:"base_url"`
-	Timeout string `json:"timeout"`
+	Token        string   `json:"token"`
+	AccountToken string   `json:"account_token,omitempty"`
+	BaseURL      string   `json:"base_url"`
+	Timeout      string   `json:"timeout"`
+	Retries      *bool    `json:"retries,omitempty"`
+	MaxAttempts  int      `json:"max_attempts,omitempty"`
+	RetryMaxWait string   `json:"retry_max_wait,omitempty"`
+	PathRewrites []string `json:"path_rewrites,omitempty"`
+	ProjectSlug  string   `json:"project_slug,omitempty"`
 }
 
 func applyConfigDefaults(cmd *cobra.Command, cfg *cliConfig) error {
@@ -32,6 +39,9 @@ func applyConfigDefaults(cmd *cobra.Command, cfg *cliConfig) error {
 		if !cmd.Flags().Changed("token") && strings.TrimSpace(cfg.Token) == "" && strings.TrimSpace(profile.Token) != "" {
 			cfg.Token = strings.TrimSpace(profile.Token)
 		}
+		if !cmd.Flags().Changed("account-token") && strings.TrimSpace(cfg.AccountToken) == "" && strings.TrimSpace(profile.AccountToken) != "" {
+			cfg.AccountToken = strings.TrimSpace(profile.AccountToken)
+		}
 		if !cmd.Flags().Changed("base-url") && cfg.BaseURL == defaultBaseURL && strings.TrimSpace(profile.BaseURL) != "" {
 			cfg.BaseURL = strings.TrimSpace(profile.BaseURL)
 		}
@@ -42,11 +52,42 @@ func applyConfigDefaults(cmd *cobra.Command, cfg *cliConfig) error {
 			}
 			cfg.Timeout = parsed
 		}
+		if !cmd.Flags().Changed("retries") && profile.Retries != nil {
+			cfg.Retries = *profile.Retries
+		}
+		if !cmd.Flags().Changed("max-attempts") && cfg.MaxAttempts == defaultMaxAttempts && profile.MaxAttempts > 0 {
+			cfg.MaxAttempts = profile.MaxAttempts
+		}
+		if !cmd.Flags().Changed("retry-max-wait") && cfg.RetryMaxWait == defaultRetryMaxWait && strings.TrimSpace(profile.RetryMaxWait) != "" {
+			parsed, err := time.ParseDuration(strings.TrimSpace(profile.RetryMaxWait))
+			if err != nil {
+				return fmt.Errorf("parse retry_max_wait for profile %q: %w", cfg.Profile, err)
+			}
+			if parsed <= 0 {
+				return fmt.Errorf("retry_max_wait for profile %q must be > 0", cfg.Profile)
+			}
+			cfg.RetryMaxWait = parsed
+		}
+		cfg.PathRewrites = profile.PathRewrites
+		cfg.ProjectSlug = strings.Trim(strings.TrimSpace(profile.ProjectSlug), "/")
+	}
+
+	if cmd.Flags().Changed("max-attempts") && cfg.MaxAttempts < 1 {
+		return fmt.Errorf("--max-attempts must be >= 1")
+	}
+	if cmd.Flags().Changed("retry-max-wait") && cfg.RetryMaxWait <= 0 {
+		return fmt.Errorf("--retry-max-wait must be > 0")
 	}
 
 	if !cmd.Flags().Changed("token") && strings.TrimSpace(cfg.Token) == "" {
 		cfg.Token = strings.TrimSpace(os.Getenv("ROLLBAR_ACCESS_TOKEN"))
 	}
+	if !cmd.Flags().Changed("account-token") && strings.TrimSpace(cfg.AccountToken) == "" {
+		cfg.AccountToken = strings.TrimSpace(os.Getenv("ROLLBAR_ACCOUNT_ACCESS_TOKEN"))
+	}
+	if envSlug := strings.Trim(strings.TrimSpace(os.Getenv("ROLLBAR_PROJECT_SLUG")), "/"); cfg.ProjectSlug == "" && envSlug != "" {
+		cfg.ProjectSlug = envSlug
+	}
 	if !cmd.Flags().Changed("base-url") && cfg.BaseURL == defaultBaseURL {
 		if envBaseURL := strings.TrimSpace(os.Getenv("ROLLBAR_BASE_URL")); envBaseURL != "" {
 			cfg.BaseURL = envBaseURL
@@ -122,3 +163,198 @@ func resolveConfigPath(cfg *cliConfig) (string, error) {
 	}
 	return "", err
 }
+
+// updateSelectedProfile rewrites the config file used by loadSelectedProfile,
+// applying update to the selected profile's JSON object. Keys keep their
+// order and unknown keys are preserved. The file is replaced atomically with
+// mode 0600, so an interrupted write never leaves a truncated config. It
+// returns the path that was written.
+func updateSelectedProfile(cfg *cliConfig, update func(profile *jsonObject) error) (string, error) {
+	path, err := resolveConfigPath(cfg)
+	if err != nil {
+		return "", err
+	}
+	if path == "" {
+		return "", fmt.Errorf("no config file found: pass --config or set ROLLBAR_CLI_CONFIG")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read config file %q: %w", path, err)
+	}
+
+	var raw jsonObject
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return "", fmt.Errorf("parse config file %q: %w", path, err)
+	}
+
+	profileName := strings.TrimSpace(cfg.Profile)
+	if profileName == "" {
+		profileName = strings.TrimSpace(raw.getString("default_profile"))
+	}
+	if profileName == "" {
+		return "", fmt.Errorf("no profile selected in %s: pass --profile or set default_profile", path)
+	}
+
+	var profiles jsonObject
+	var profile jsonObject
+	value, ok := raw.get("profiles")
+	if ok {
+		ok = json.Unmarshal(value, &profiles) == nil
+	}
+	if ok {
+		value, ok = profiles.get(profileName)
+	}
+	if ok {
+		ok = json.Unmarshal(value, &profile) == nil
+	}
+	if !ok {
+		return "", fmt.Errorf("profile %q not found in %s", profileName, path)
+	}
+	if err := update(&profile); err != nil {
+		return "", err
+	}
+	if err := profiles.set(profileName, profile); err != nil {
+		return "", err
+	}
+	if err := raw.set("profiles", profiles); err != nil {
+		return "", err
+	}
+
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(raw); err != nil {
+		return "", err
+	}
+	if err := writeFileAtomic(path, out.Bytes(), 0o600); err != nil {
+		return "", fmt.Errorf("write config file %q: %w", path, err)
+	}
+	return path, nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames
+// it into place.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// jsonObject is a JSON object that keeps its keys in document order, so
+// rewriting one value leaves the rest of a config file as it was.
+type jsonObject []jsonMember
+
+type jsonMember struct {
+	Key   string
+	Value json.RawMessage
+}
+
+func (o jsonObject) get(key string) (json.RawMessage, bool) {
+	for _, member := range o {
+		if member.Key == key {
+			return member.Value, true
+		}
+	}
+	return nil, false
+}
+
+// getString returns key's value when it is a string.
+func (o jsonObject) getString(key string) string {
+	var s string
+	if value, ok := o.get(key); ok {
+		_ = json.Unmarshal(value, &s)
+	}
+	return s
+}
+
+// set replaces key's value in place, or appends key when it is new.
+func (o *jsonObject) set(key string, v any) error {
+	value, err := marshalUnescaped(v)
+	if err != nil {
+		return err
+	}
+	for i := range *o {
+		if (*o)[i].Key == key {
+			(*o)[i].Value = value
+			return nil
+		}
+	}
+	*o = append(*o, jsonMember{Key: key, Value: value})
+	return nil
+}
+
+func (o *jsonObject) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return err
+	} else if tok != json.Delim('{') {
+		return fmt.Errorf("expected a JSON object")
+	}
+	*o = nil
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		*o = append(*o, jsonMember{Key: key, Value: value})
+	}
+	_, err := dec.Token()
+	return err
+}
+
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, member := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := marshalUnescaped(member.Key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(member.Value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// marshalUnescaped is json.Marshal without the HTML escaping that would
+// turn a "<" in a config value into "\u003c".
+func marshalUnescaped(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
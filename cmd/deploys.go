@@ -19,14 +19,15 @@ var validDeployStatuses = map[string]struct{}{
 }
 
 type deploysListOptions struct {
-	Page      int
-	Limit     int
-	Output    string
-	JSON      bool
-	RawJSON   bool
-	NDJSON    bool
-	Fields    []string
-	NoHeaders bool
+	Page       int
+	Pagination paginationOptions
+	Limit      int
+	Output     string
+	JSON       bool
+	RawJSON    bool
+	NDJSON     bool
+	Fields     []string
+	NoHeaders  bool
 }
 
 type deploysGetOptions struct {
@@ -35,6 +36,7 @@ type deploysGetOptions struct {
 	JSON    bool
 	RawJSON bool
 	NDJSON  bool
+	Fields  []string
 }
 
 type deploysCreateOptions struct {
@@ -60,7 +62,8 @@ type deploysUpdateOptions struct {
 }
 
 type deployListJSONOutput struct {
-	Deploys []rollbar.Deploy `json:"deploys"`
+	SchemaVersion int              `json:"schema_version"`
+	Deploys       []rollbar.Deploy `json:"deploys"`
 }
 
 type deployGetJSONOutput struct {
@@ -101,7 +104,7 @@ func newDeploysCmd(cfg *cliConfig) *cobra.Command {
 				return err
 			}
 
-			output, err := resolveOutputModeWithAliases(getOpts.Output, getOpts.JSON, getOpts.RawJSON, getOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, getOpts.Output, getOpts.JSON, getOpts.RawJSON, getOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
 			if err != nil {
 				return err
 			}
@@ -116,7 +119,7 @@ func newDeploysCmd(cfg *cliConfig) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return writeSingleDeployOutput(resp.Deploy, resp.Raw, output)
+			return writeSingleDeployOutput(cfg.Filter, resp.Deploy, resp.Raw, output, normalizeFields(getOpts.Fields))
 		},
 	}
 
@@ -133,17 +136,23 @@ func newDeploysCmd(cfg *cliConfig) *cobra.Command {
 				return err
 			}
 
-			output, err := resolveOutputModeWithAliases(createOpts.Output, createOpts.JSON, createOpts.RawJSON, createOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, createOpts.Output, createOpts.JSON, createOpts.RawJSON, createOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
 			if err != nil {
 				return err
 			}
 
+			if environment := body["environment"].(string); isProductionEnvironment(environment) {
+				if err := confirmAction(cmd, cfg, fmt.Sprintf("record a deploy of %s to %s", body["revision"], environment)); err != nil {
+					return err
+				}
+			}
+
 			client := newRollbarClient(cfg)
 			resp, err := client.CreateDeploy(cmd.Context(), body)
 			if err != nil {
 				return err
 			}
-			return writeSingleDeployOutput(resp.Deploy, resp.Raw, output)
+			return writeSingleDeployOutput(cfg.Filter, resp.Deploy, resp.Raw, output, nil)
 		},
 	}
 
@@ -166,34 +175,50 @@ func newDeploysCmd(cfg *cliConfig) *cobra.Command {
 				return err
 			}
 
-			output, err := resolveOutputModeWithAliases(updateOpts.Output, updateOpts.JSON, updateOpts.RawJSON, updateOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, updateOpts.Output, updateOpts.JSON, updateOpts.RawJSON, updateOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
 			if err != nil {
 				return err
 			}
 
 			client := newRollbarClient(cfg)
+			if !cfg.Yes && !cfg.DryRun {
+				// Only the stored deploy knows its environment, so look it up
+				// when a production change may need confirming.
+				current, err := client.GetDeployByID(cmd.Context(), id)
+				if err != nil {
+					return err
+				}
+				if isProductionEnvironment(current.Deploy.Environment) {
+					action := fmt.Sprintf("mark deploy %d in %s as %s", id, current.Deploy.Environment, body["status"])
+					if err := confirmAction(cmd, cfg, action); err != nil {
+						return err
+					}
+				}
+			}
 			resp, err := client.UpdateDeployByID(cmd.Context(), id, body)
 			if err != nil {
 				return err
 			}
-			return writeSingleDeployOutput(resp.Deploy, resp.Raw, output)
+			return writeSingleDeployOutput(cfg.Filter, resp.Deploy, resp.Raw, output, nil)
 		},
 	}
 
-	listCmd.Flags().IntVar(&listOpts.Page, "page", 1, "Page number")
+	listCmd.Flags().IntVar(&listOpts.Page, "page", 1, "Starting page number")
+	addPaginationFlags(listCmd.Flags(), &listOpts.Pagination, 1)
 	listCmd.Flags().IntVar(&listOpts.Limit, "limit", 0, "Maximum number of deploys to return")
-	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson")
+	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
 	listCmd.Flags().BoolVar(&listOpts.JSON, "json", false, "Shortcut for --output json")
 	listCmd.Flags().BoolVar(&listOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
 	listCmd.Flags().BoolVar(&listOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
-	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text output")
-	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text output")
+	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text, csv, tsv, yaml, and markdown output")
+	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
 
 	getCmd.Flags().Int64Var(&getOpts.ID, "id", 0, "Deploy ID")
-	getCmd.Flags().StringVarP(&getOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson")
+	getCmd.Flags().StringVarP(&getOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
 	getCmd.Flags().BoolVar(&getOpts.JSON, "json", false, "Shortcut for --output json")
 	getCmd.Flags().BoolVar(&getOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
 	getCmd.Flags().BoolVar(&getOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	getCmd.Flags().StringSliceVar(&getOpts.Fields, "fields", nil, "Fields to render in csv, tsv, yaml, and markdown output")
 
 	createCmd.Flags().StringVar(&createOpts.Environment, "environment", "", "Deploy environment")
 	createCmd.Flags().StringVar(&createOpts.Revision, "revision", "", "Deploy revision")
@@ -201,14 +226,14 @@ func newDeploysCmd(cfg *cliConfig) *cobra.Command {
 	createCmd.Flags().StringVar(&createOpts.Comment, "comment", "", "Deploy comment")
 	createCmd.Flags().StringVar(&createOpts.LocalUsername, "local-username", "", "Local deploy username")
 	createCmd.Flags().StringVar(&createOpts.RollbarUsername, "rollbar-username", "", "Rollbar username")
-	createCmd.Flags().StringVarP(&createOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson")
+	createCmd.Flags().StringVarP(&createOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|template")
 	createCmd.Flags().BoolVar(&createOpts.JSON, "json", false, "Shortcut for --output json")
 	createCmd.Flags().BoolVar(&createOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
 	createCmd.Flags().BoolVar(&createOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
 
 	updateCmd.Flags().Int64Var(&updateOpts.ID, "id", 0, "Deploy ID")
 	updateCmd.Flags().StringVar(&updateOpts.Status, "status", "", "Deploy status: started|succeeded|failed|timed_out")
-	updateCmd.Flags().StringVarP(&updateOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson")
+	updateCmd.Flags().StringVarP(&updateOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|template")
 	updateCmd.Flags().BoolVar(&updateOpts.JSON, "json", false, "Shortcut for --output json")
 	updateCmd.Flags().BoolVar(&updateOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
 	updateCmd.Flags().BoolVar(&updateOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
@@ -218,7 +243,7 @@ func newDeploysCmd(cfg *cliConfig) *cobra.Command {
 }
 
 func runDeploysList(cmd *cobra.Command, cfg *cliConfig, opts deploysListOptions) error {
-	output, err := resolveOutputModeWithAliases(opts.Output, opts.JSON, opts.RawJSON, opts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+	output, err := resolveOutputModeWithAliases(cfg.Filter, opts.Output, opts.JSON, opts.RawJSON, opts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
 	if err != nil {
 		return err
 	}
@@ -230,15 +255,17 @@ func runDeploysList(cmd *cobra.Command, cfg *cliConfig, opts deploysListOptions)
 
 	switch output {
 	case outputRawJSON:
-		return writeJSON(raw)
+		return writeJSON(cfg.Filter, raw)
 	case outputJSON:
-		return writeJSON(deployListJSONOutput{Deploys: deploys})
+		return writeJSON(cfg.Filter, deployListJSONOutput{SchemaVersion: jsonSchemaVersion, Deploys: deploys})
 	case outputNDJSON:
 		records := make([]any, 0, len(deploys))
 		for _, deploy := range deploys {
 			records = append(records, deploy)
 		}
-		return writeNDJSON(records)
+		return writeNDJSON(cfg.Filter, records)
+	case outputCSV, outputTSV, outputYAML, outputMarkdown:
+		return writeTable(output, ui.DeploysTable(deploys, normalizeFields(opts.Fields)), opts.NoHeaders)
 	default:
 		return ui.RenderDeploysWithOptions(deploys, ui.DeployRenderOptions{
 			Fields:    normalizeFields(opts.Fields),
@@ -247,40 +274,38 @@ func runDeploysList(cmd *cobra.Command, cfg *cliConfig, opts deploysListOptions)
 	}
 }
 
-func collectDeploys(cmd *cobra.Command, cfg *cliConfig, opts deploysListOptions) ([]rollbar.Deploy, map[string]any, error) {
+func collectDeploys(cmd *cobra.Command, cfg *cliConfig, opts deploysListOptions) ([]rollbar.Deploy, any, error) {
 	if opts.Limit < 0 {
 		return nil, nil, fmt.Errorf("--limit must be >= 0")
 	}
-
-	client := newRollbarClient(cfg)
-	page := opts.Page
-	if page <= 0 {
-		page = 1
+	pagerOpts, err := opts.Pagination.pagerOptions(opts.Page)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	resp, err := client.ListDeploys(cmd.Context(), rollbar.ListDeploysOptions{
-		Page:  page,
-		Limit: opts.Limit,
-	})
+	client := newRollbarClient(cfg)
+	pager := client.DeploysPager(rollbar.ListDeploysOptions{Limit: opts.Limit}, pagerOpts)
+	deploys, rawPages, err := collectPages(cmd.Context(), pager, opts.Limit)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	deploys := resp.Deploys
 	if opts.Limit > 0 && len(deploys) > opts.Limit {
 		deploys = deploys[:opts.Limit]
 	}
-	return deploys, resp.Raw, nil
+	return deploys, rawPagesOutput(rawPages), nil
 }
 
-func writeSingleDeployOutput(deploy rollbar.Deploy, raw map[string]any, output string) error {
+func writeSingleDeployOutput(filter outputFilter, deploy rollbar.Deploy, raw map[string]any, output string, fields []string) error {
 	switch output {
 	case outputRawJSON:
-		return writeJSON(raw)
+		return writeJSON(filter, raw)
 	case outputJSON:
-		return writeJSON(deployGetJSONOutput{Deploy: deploy})
+		return writeJSON(filter, deployGetJSONOutput{Deploy: deploy})
 	case outputNDJSON:
-		return writeNDJSON([]any{deploy})
+		return writeNDJSON(filter, []any{deploy})
+	case outputCSV, outputTSV, outputYAML, outputMarkdown:
+		return writeTable(output, ui.DeploysTable([]rollbar.Deploy{deploy}, fields), false)
 	default:
 		return ui.RenderDeploy(deploy)
 	}
@@ -308,6 +333,10 @@ func resolveDeployID(cmd *cobra.Command, args []string, id int64) (int64, error)
 	}
 
 	if arg != "" {
+		if isWebURL(arg) {
+			ref, err := parseRollbarURL(arg, "deploy")
+			return ref.ID, err
+		}
 		if !isIntegerToken(arg) {
 			return 0, fmt.Errorf("invalid deploy id %q: must be > 0", arg)
 		}
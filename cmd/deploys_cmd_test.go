@@ -36,7 +36,7 @@ func TestDeploysListCommandJSON(t *testing.T) {
 	if len(gotLimits) != 1 || gotLimits[0] != "1" {
 		t.Fatalf("unexpected limits: %#v", gotLimits)
 	}
-	if !strings.Contains(out, "\"deploys\"") || strings.Contains(out, "\"ID\": 124") || !strings.Contains(out, "\"ID\": 123") {
+	if !strings.Contains(out, "\"deploys\"") || strings.Contains(out, "\"id\": 124") || !strings.Contains(out, "\"id\": 123") {
 		t.Fatalf("unexpected output: %q", out)
 	}
 }
@@ -85,7 +85,7 @@ func TestDeploysGetCommandNDJSON(t *testing.T) {
 	if gotPath != "/api/1/deploy/123" {
 		t.Fatalf("unexpected request path: %s", gotPath)
 	}
-	if strings.Contains(out, "\"deploy\"") || !strings.Contains(out, "\"Status\":\"started\"") {
+	if strings.Contains(out, "\"deploy\"") || !strings.Contains(out, "\"status\":\"started\"") {
 		t.Fatalf("unexpected ndjson output: %q", out)
 	}
 }
@@ -116,6 +116,7 @@ func TestDeploysCreateCommandJSON(t *testing.T) {
 		"--local-username", "ci-bot",
 		"--rollbar-username", "alice",
 		"--json",
+		"--yes",
 		"--token", "tok",
 		"--base-url", ts.URL,
 	)
@@ -125,7 +126,7 @@ func TestDeploysCreateCommandJSON(t *testing.T) {
 	if gotBody["environment"] != "production" || gotBody["revision"] != "aabbcc1" || gotBody["rollbar_username"] != "alice" {
 		t.Fatalf("unexpected request body: %#v", gotBody)
 	}
-	if !strings.Contains(out, "\"deploy\"") || !strings.Contains(out, "\"ID\": 123") {
+	if !strings.Contains(out, "\"deploy\"") || !strings.Contains(out, "\"id\": 123") {
 		t.Fatalf("unexpected output: %q", out)
 	}
 }
@@ -151,6 +152,7 @@ func TestDeploysUpdateCommandJSON(t *testing.T) {
 		"deploys", "update", "123",
 		"--status", "succeeded",
 		"--json",
+		"--yes",
 		"--token", "tok",
 		"--base-url", ts.URL,
 	)
@@ -160,7 +162,7 @@ func TestDeploysUpdateCommandJSON(t *testing.T) {
 	if len(gotBody) != 1 || gotBody["status"] != "succeeded" {
 		t.Fatalf("unexpected request body: %#v", gotBody)
 	}
-	if !strings.Contains(out, "\"Status\": \"succeeded\"") {
+	if !strings.Contains(out, "\"status\": \"succeeded\"") {
 		t.Fatalf("unexpected output: %q", out)
 	}
 }
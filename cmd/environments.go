@@ -8,16 +8,18 @@ import (
 )
 
 type environmentsListOptions struct {
-	Output    string
-	JSON      bool
-	RawJSON   bool
-	NDJSON    bool
-	Fields    []string
-	NoHeaders bool
+	Pagination paginationOptions
+	Output     string
+	JSON       bool
+	RawJSON    bool
+	NDJSON     bool
+	Fields     []string
+	NoHeaders  bool
 }
 
 type environmentListJSONOutput struct {
-	Environments []rollbar.Environment `json:"environments"`
+	SchemaVersion int                   `json:"schema_version"`
+	Environments  []rollbar.Environment `json:"environments"`
 }
 
 type environmentListRawOutput struct {
@@ -40,30 +42,37 @@ func newEnvironmentsCmd(cfg *cliConfig) *cobra.Command {
 				return err
 			}
 
-			output, err := resolveOutputModeWithAliases(listOpts.Output, listOpts.JSON, listOpts.RawJSON, listOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, listOpts.Output, listOpts.JSON, listOpts.RawJSON, listOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+
+			pagerOpts, err := listOpts.Pagination.pagerOptions(1)
 			if err != nil {
 				return err
 			}
 
 			client := newRollbarClient(cfg)
-			resp, err := client.ListEnvironments(cmd.Context())
+			environments, rawPages, err := collectPages(cmd.Context(), client.EnvironmentsPager(pagerOpts), 0)
 			if err != nil {
 				return err
 			}
 
 			switch output {
 			case outputRawJSON:
-				return writeJSON(environmentListRawOutput{Pages: resp.RawPages})
+				return writeJSON(cfg.Filter, environmentListRawOutput{Pages: rawPages})
 			case outputJSON:
-				return writeJSON(environmentListJSONOutput{Environments: resp.Environments})
+				return writeJSON(cfg.Filter, environmentListJSONOutput{SchemaVersion: jsonSchemaVersion, Environments: environments})
 			case outputNDJSON:
-				records := make([]any, 0, len(resp.Environments))
-				for _, environment := range resp.Environments {
+				records := make([]any, 0, len(environments))
+				for _, environment := range environments {
 					records = append(records, environment)
 				}
-				return writeNDJSON(records)
+				return writeNDJSON(cfg.Filter, records)
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.EnvironmentsTable(environments, normalizeFields(listOpts.Fields)), listOpts.NoHeaders)
 			default:
-				return ui.RenderEnvironmentsWithOptions(resp.Environments, ui.EnvironmentRenderOptions{
+				return ui.RenderEnvironmentsWithOptions(environments, ui.EnvironmentRenderOptions{
 					Fields:    normalizeFields(listOpts.Fields),
 					NoHeaders: listOpts.NoHeaders,
 				})
@@ -71,12 +80,13 @@ func newEnvironmentsCmd(cfg *cliConfig) *cobra.Command {
 		},
 	}
 
-	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson")
+	addPaginationFlags(listCmd.Flags(), &listOpts.Pagination, 0)
+	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
 	listCmd.Flags().BoolVar(&listOpts.JSON, "json", false, "Shortcut for --output json")
 	listCmd.Flags().BoolVar(&listOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
 	listCmd.Flags().BoolVar(&listOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
-	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text output")
-	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text output")
+	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text, csv, tsv, yaml, and markdown output")
+	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
 
 	environmentsCmd.AddCommand(listCmd)
 	return environmentsCmd
@@ -4,14 +4,18 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 )
 
 func TestEnvironmentsListCommandJSONPaginates(t *testing.T) {
-	var gotPages []string
+	var mu sync.Mutex
+	gotPages := map[string]bool{}
 
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		gotPages = append(gotPages, r.URL.Query().Get("page"))
+		mu.Lock()
+		gotPages[r.URL.Query().Get("page")] = true
+		mu.Unlock()
 		switch r.URL.Query().Get("page") {
 		case "1":
 			_, _ = w.Write([]byte(`{"err":0,"result":{"environments":[
@@ -55,10 +59,15 @@ func TestEnvironmentsListCommandJSONPaginates(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unexpected command error: %v", err)
 	}
-	if len(gotPages) != 3 || gotPages[0] != "1" || gotPages[1] != "2" || gotPages[2] != "3" {
+	mu.Lock()
+	defer mu.Unlock()
+	if !gotPages["1"] || !gotPages["2"] || !gotPages["3"] {
 		t.Fatalf("unexpected requested pages: %#v", gotPages)
 	}
-	if !strings.Contains(out, "\"environments\"") || !strings.Contains(out, "\"Name\": \"sandbox\"") {
+	if strings.Count(out, "\"name\": \"sandbox\"") != 1 {
+		t.Fatalf("expected pages past the end to be ignored: %q", out)
+	}
+	if !strings.Contains(out, "\"environments\"") || !strings.Contains(out, "\"name\": \"sandbox\"") {
 		t.Fatalf("unexpected output: %q", out)
 	}
 }
@@ -89,3 +98,30 @@ func TestEnvironmentsListCommandRawJSONAggregatesPages(t *testing.T) {
 		t.Fatalf("unexpected raw-json output: %q", out)
 	}
 }
+
+func TestEnvironmentsListCommandMaxPages(t *testing.T) {
+	var mu sync.Mutex
+	var gotPages []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		gotPages = append(gotPages, r.URL.Query().Get("page"))
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"err":0,"result":{"environments":[{"id":1,"project_id":42,"environment":"production"}]}}`))
+	}))
+	defer ts.Close()
+
+	_, err := runCLIWithCapturedStdout(t,
+		"environments", "list",
+		"--json",
+		"--max-pages", "2",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if len(gotPages) != 2 {
+		t.Fatalf("expected 2 page requests, got %#v", gotPages)
+	}
+}
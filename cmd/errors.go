@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+const (
+	ExitOK           = 0
+	ExitError        = 1
+	ExitAPIError     = 2
+	ExitUnauthorized = 3
+	ExitNotFound     = 4
+	ExitRateLimited  = 5
+	ExitServerError  = 6
+)
+
+type jsonOutputError struct {
+	err error
+}
+
+type errorJSONOutput struct {
+	Error errorJSONDetails `json:"error"`
+}
+
+type errorJSONDetails struct {
+	Type       string `json:"type"`
+	Message    string `json:"message"`
+	ExitCode   int    `json:"exit_code"`
+	Status     int    `json:"status,omitempty"`
+	RollbarErr int    `json:"rollbar_err,omitempty"`
+	Method     string `json:"method,omitempty"`
+	Path       string `json:"path,omitempty"`
+}
+
+func (e *jsonOutputError) Error() string {
+	return e.err.Error()
+}
+
+func (e *jsonOutputError) Unwrap() error {
+	return e.err
+}
+
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.Is(err, rollbar.ErrUnauthorized):
+		return ExitUnauthorized
+	case errors.Is(err, rollbar.ErrNotFound):
+		return ExitNotFound
+	case errors.Is(err, rollbar.ErrRateLimited):
+		return ExitRateLimited
+	case errors.Is(err, rollbar.ErrServer):
+		return ExitServerError
+	}
+	var apiErr *rollbar.APIError
+	if errors.As(err, &apiErr) {
+		return ExitAPIError
+	}
+	return ExitError
+}
+
+func WriteError(w io.Writer, err error) error {
+	var jsonErr *jsonOutputError
+	if !errors.As(err, &jsonErr) {
+		_, writeErr := fmt.Fprintln(w, err)
+		return writeErr
+	}
+
+	details := errorJSONDetails{
+		Type:     errorType(err),
+		Message:  err.Error(),
+		ExitCode: ExitCode(err),
+	}
+	var apiErr *rollbar.APIError
+	if errors.As(err, &apiErr) {
+		details.Status = apiErr.StatusCode
+		details.RollbarErr = apiErr.Code
+		details.Method = apiErr.Method
+		details.Path = apiErr.Path
+	}
+	return json.NewEncoder(w).Encode(errorJSONOutput{Error: details})
+}
+
+func errorType(err error) string {
+	switch ExitCode(err) {
+	case ExitUnauthorized:
+		return "unauthorized"
+	case ExitNotFound:
+		return "not_found"
+	case ExitRateLimited:
+		return "rate_limited"
+	case ExitServerError:
+		return "server_error"
+	case ExitAPIError:
+		return "api_error"
+	default:
+		return "error"
+	}
+}
+
+func wantsJSONErrors(cmd *cobra.Command) bool {
+	if cmd == nil {
+		return false
+	}
+	for _, name := range []string{"json", "raw-json", "ndjson"} {
+		if flag := cmd.Flags().Lookup(name); flag != nil && flag.Value.String() == "true" {
+			return true
+		}
+	}
+	if flag := cmd.Flags().Lookup("output"); flag != nil {
+		switch flag.Value.String() {
+		case outputJSON, outputRawJSON, outputNDJSON:
+			return true
+		}
+	}
+	return false
+}
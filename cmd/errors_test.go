@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "nil", err: nil, want: ExitOK},
+		{name: "plain", err: errors.New("boom"), want: ExitError},
+		{name: "unauthorized", err: &rollbar.APIError{StatusCode: http.StatusForbidden}, want: ExitUnauthorized},
+		{name: "not found", err: fmt.Errorf("wrapped: %w", &rollbar.APIError{StatusCode: http.StatusNotFound}), want: ExitNotFound},
+		{name: "rate limited", err: &rollbar.APIError{StatusCode: http.StatusTooManyRequests}, want: ExitRateLimited},
+		{name: "server", err: fmt.Errorf("wrapped: %w", &rollbar.APIError{StatusCode: http.StatusBadGateway}), want: ExitServerError},
+		{name: "other api", err: &rollbar.APIError{StatusCode: http.StatusOK, Code: 1}, want: ExitAPIError},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ExitCode(tc.err); got != tc.want {
+				t.Fatalf("ExitCode() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExecuteWritesJSONErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"err":1,"message":"Item not found"}`))
+	}))
+	defer ts.Close()
+
+	cmd := newRootCmd()
+	cmd.SetArgs([]string{"items", "get", "42", "--json", "--retries=false", "--token", "tok", "--base-url", ts.URL})
+	executed, err := cmd.ExecuteC()
+	if err == nil || !wantsJSONErrors(executed) {
+		t.Fatalf("expected JSON-mode error, got %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteError(&buf, &jsonOutputError{err: err}); err != nil {
+		t.Fatalf("WriteError() error = %v", err)
+	}
+	var got errorJSONOutput
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("decode error JSON %q: %v", buf.String(), err)
+	}
+	if got.Error.Type != "not_found" || got.Error.ExitCode != ExitNotFound || got.Error.Status != http.StatusNotFound || got.Error.Path != "/api/1/item/42" {
+		t.Fatalf("unexpected error JSON: %#v", got)
+	}
+}
+
+func TestWriteErrorPlainText(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteError(&buf, errors.New("boom")); err != nil {
+		t.Fatalf("WriteError() error = %v", err)
+	}
+	if buf.String() != "boom\n" {
+		t.Fatalf("unexpected output: %q", buf.String())
+	}
+}
@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+	"github.com/davebarnwell/rollbar-cli/internal/ui"
+)
+
+const defaultHistoryLimit = 20
+
+type historyOptions struct {
+	Limit     int
+	ItemID    int64
+	Output    string
+	JSON      bool
+	NDJSON    bool
+	NoHeaders bool
+}
+
+type undoOptions struct {
+	Output  string
+	JSON    bool
+	RawJSON bool
+}
+
+type historyJSONOutput struct {
+	Entries []auditEntry `json:"entries"`
+}
+
+func newHistoryCmd(cfg *cliConfig) *cobra.Command {
+	var opts historyOptions
+
+	historyCmd := &cobra.Command{
+		Use:   "history",
+		Short: "List recent item changes from the local audit log",
+		Long:  "List item changes made by this CLI, newest first. Every item update is recorded with the item's state before and after the change in a local audit log, so it can be reverted with `rollbar-cli undo`.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Limit < 0 {
+				return fmt.Errorf("--limit must be >= 0")
+			}
+			output, err := resolveOutputModeWithAliases(cfg.Filter, opts.Output, opts.JSON, false, opts.NDJSON, outputText, outputJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+
+			entries, err := readAuditEntries()
+			if err != nil {
+				return err
+			}
+			undone := undoneAuditEntries(entries)
+
+			recent := make([]auditEntry, 0, len(entries))
+			for _, entry := range slices.Backward(entries) {
+				if opts.ItemID > 0 && entry.ItemID != opts.ItemID {
+					continue
+				}
+				recent = append(recent, entry)
+				if opts.Limit > 0 && len(recent) == opts.Limit {
+					break
+				}
+			}
+
+			switch output {
+			case outputJSON:
+				return writeJSON(cfg.Filter, historyJSONOutput{Entries: recent})
+			case outputNDJSON:
+				records := make([]any, 0, len(recent))
+				for _, entry := range recent {
+					records = append(records, entry)
+				}
+				return writeNDJSON(cfg.Filter, records)
+			}
+
+			if len(recent) == 0 && output == outputText {
+				return writeStdoutf("No item changes recorded.\n")
+			}
+			rows := make([][]string, 0, len(recent))
+			for _, entry := range recent {
+				change := describeAuditChange(entry)
+				if by, ok := undone[entry.Entry]; ok {
+					change += fmt.Sprintf(" (undone by #%d)", by)
+				}
+				rows = append(rows, []string{
+					strconv.Itoa(entry.Entry),
+					entry.Timestamp.UTC().Format(time.RFC3339),
+					valueOrDash(entry.Profile),
+					entry.Source,
+					strconv.FormatInt(entry.ItemID, 10),
+					change,
+				})
+			}
+			if isTableOutput(output) {
+				return writeTable(output, ui.Table{Fields: []string{"entry", "time", "profile", "source", "item", "change"}, Rows: rows}, opts.NoHeaders)
+			}
+			return renderRows([]string{"ENTRY", "TIME", "PROFILE", "SOURCE", "ITEM", "CHANGE"}, rows, !opts.NoHeaders)
+		},
+	}
+
+	historyCmd.Flags().IntVar(&opts.Limit, "limit", defaultHistoryLimit, "Maximum number of entries to show (0 for all)")
+	historyCmd.Flags().Int64Var(&opts.ItemID, "item", 0, "Only show changes to this item ID")
+	historyCmd.Flags().StringVarP(&opts.Output, "output", "o", outputText, "Output format: text|json|ndjson|csv|tsv|yaml|markdown|template")
+	historyCmd.Flags().BoolVar(&opts.JSON, "json", false, "Shortcut for --output json")
+	historyCmd.Flags().BoolVar(&opts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	historyCmd.Flags().BoolVar(&opts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
+
+	return historyCmd
+}
+
+func newUndoCmd(cfg *cliConfig) *cobra.Command {
+	var opts undoOptions
+
+	undoCmd := &cobra.Command{
+		Use:   "undo [entry]",
+		Short: "Revert an item change recorded in the audit log",
+		Long:  "Send the inverse update for an audit log entry, restoring the item's previous status, level, title, and assignment. Without an entry number, the most recent change that has not been undone is reverted.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireToken(cfg); err != nil {
+				return err
+			}
+			output, err := resolveOutputModeWithAliases(cfg.Filter, opts.Output, opts.JSON, opts.RawJSON, false, outputText, outputJSON, outputRawJSON)
+			if err != nil {
+				return err
+			}
+
+			entries, err := readAuditEntries()
+			if err != nil {
+				return err
+			}
+			entry, err := selectUndoEntry(entries, args)
+			if err != nil {
+				return err
+			}
+			if entry.Profile != cfg.Profile {
+				return fmt.Errorf("entry %d was made with profile %q; rerun with --profile %q", entry.Entry, entry.Profile, entry.Profile)
+			}
+			body, err := inverseItemUpdate(entry)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarClient(cfg)
+			current, err := client.GetItemByID(cmd.Context(), entry.ItemID)
+			if err != nil {
+				return err
+			}
+			if isProductionEnvironment(current.Item.Environment) {
+				action := fmt.Sprintf("undo entry %d on item %d in %s", entry.Entry, entry.ItemID, current.Item.Environment)
+				if err := confirmAction(cmd, cfg, action); err != nil {
+					return err
+				}
+			}
+
+			updateResp, err := updateItemAudited(cmd, cfg, client, entry.ItemID, current, body, auditEntry{Source: auditSourceUndo, Undoes: entry.Entry})
+			var plan *rollbar.DryRunError
+			if errors.As(err, &plan) {
+				return writeDryRunPlan(cfg.Filter, plan, &current.Item, output != outputText)
+			}
+			if err != nil {
+				return err
+			}
+			return writeItemUpdateResult(cmd, cfg.Filter, client, entry.ItemID, updateResp, output)
+		},
+	}
+
+	undoCmd.Flags().StringVarP(&opts.Output, "output", "o", outputText, "Output format: text|json|raw-json|template")
+	undoCmd.Flags().BoolVar(&opts.JSON, "json", false, "Shortcut for --output json")
+	undoCmd.Flags().BoolVar(&opts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+
+	return undoCmd
+}
+
+// undoneAuditEntries maps each undone entry number to the entry that undid it.
+func undoneAuditEntries(entries []auditEntry) map[int]int {
+	undone := make(map[int]int)
+	for _, entry := range entries {
+		if entry.Undoes > 0 {
+			undone[entry.Undoes] = entry.Entry
+		}
+	}
+	return undone
+}
+
+func selectUndoEntry(entries []auditEntry, args []string) (auditEntry, error) {
+	undone := undoneAuditEntries(entries)
+
+	if len(args) == 0 {
+		for _, entry := range slices.Backward(entries) {
+			if _, ok := undone[entry.Entry]; ok || entry.Undoes > 0 {
+				continue
+			}
+			return entry, nil
+		}
+		return auditEntry{}, fmt.Errorf("no item changes to undo")
+	}
+
+	n, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(args[0]), "#"))
+	if err != nil || n <= 0 {
+		return auditEntry{}, fmt.Errorf("invalid entry %q: pass an entry number from `rollbar-cli history`", args[0])
+	}
+	for _, entry := range entries {
+		if entry.Entry != n {
+			continue
+		}
+		if by, ok := undone[n]; ok {
+			return auditEntry{}, fmt.Errorf("entry %d was already undone by entry %d", n, by)
+		}
+		return entry, nil
+	}
+	return auditEntry{}, fmt.Errorf("entry %d not found in the audit log", n)
+}
+
+// describeAuditChange summarises an entry as "field: before -> after" pairs.
+func describeAuditChange(entry auditEntry) string {
+	keys := make([]string, 0, len(entry.Body))
+	for key := range entry.Body {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		after := formatAuditValue(entry.Body[key])
+		before, ok := auditStateValue(entry.Before, key)
+		if !ok {
+			parts = append(parts, key+": "+after)
+			continue
+		}
+		parts = append(parts, key+": "+before+" -> "+after)
+	}
+	change := strings.Join(parts, ", ")
+	if entry.Undoes > 0 {
+		change += fmt.Sprintf(" (undo of #%d)", entry.Undoes)
+	}
+	return change
+}
+
+func auditStateValue(state *auditItemState, key string) (string, bool) {
+	if state == nil {
+		return "", false
+	}
+	switch key {
+	case "status":
+		return valueOrDash(state.Status), true
+	case "level":
+		return valueOrDash(state.Level), true
+	case "title":
+		return valueOrDash(state.Title), true
+	case "assigned_user_id":
+		return formatAuditValue(nullableID(state.AssignedUserID)), true
+	case "assigned_team_id":
+		return formatAuditValue(nullableID(state.AssignedTeamID)), true
+	default:
+		return "", false
+	}
+}
+
+func formatAuditValue(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return "-"
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case string:
+		return valueOrDash(value)
+	default:
+		return fmt.Sprint(value)
+	}
+}
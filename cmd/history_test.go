@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// statefulItemServer serves item 42 and applies PATCH bodies to it.
+func statefulItemServer(t *testing.T, patches *[]string) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	item := map[string]any{"id": 42, "title": "boom", "status": "active", "level": "error", "environment": "staging", "assigned_user_id": nil}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.URL.Path != "/api/1/item/42" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodPatch {
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			encoded, _ := json.Marshal(body)
+			*patches = append(*patches, string(encoded))
+			for key, value := range body {
+				item[key] = value
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"err": 0, "result": item})
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestItemChangesAreAuditedAndUndoable(t *testing.T) {
+	var patches []string
+	ts := statefulItemServer(t, &patches)
+	run := func(args ...string) (string, error) {
+		return runCLIWithCapturedStdout(t, append(args, "--token", "tok", "--base-url", ts.URL)...)
+	}
+
+	if _, err := run("items", "resolve", "42", "--json"); err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	if _, err := run("items", "assign", "42", "--assigned-user-id", "321", "--json"); err != nil {
+		t.Fatalf("assign: %v", err)
+	}
+
+	out, err := run("history", "--json")
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	var history historyJSONOutput
+	if err := json.Unmarshal([]byte(out), &history); err != nil {
+		t.Fatalf("decode history: %v\n%s", err, out)
+	}
+	if len(history.Entries) != 2 || history.Entries[0].Entry != 2 || history.Entries[1].Entry != 1 {
+		t.Fatalf("expected newest-first entries, got %#v", history.Entries)
+	}
+	resolved := history.Entries[1]
+	if resolved.Source != auditSourceCLI || resolved.ItemID != 42 || resolved.Body["status"] != "resolved" {
+		t.Fatalf("unexpected resolve entry: %#v", resolved)
+	}
+	if resolved.Before == nil || resolved.Before.Status != "active" || resolved.After == nil || resolved.After.Status != "resolved" {
+		t.Fatalf("expected before and after states, got %#v / %#v", resolved.Before, resolved.After)
+	}
+	if history.Entries[0].After == nil || history.Entries[0].After.AssignedUserID != 321 {
+		t.Fatalf("expected assignment in after state, got %#v", history.Entries[0].After)
+	}
+
+	out, err = run("history")
+	if err != nil {
+		t.Fatalf("history text: %v", err)
+	}
+	if !strings.Contains(out, "status: active -> resolved") || !strings.Contains(out, "assigned_user_id: - -> 321") {
+		t.Fatalf("unexpected history text: %q", out)
+	}
+
+	if _, err := run("undo", "--profile", "prod"); err == nil || !strings.Contains(err.Error(), `made with profile ""`) {
+		t.Fatalf("expected profile mismatch error, got %v", err)
+	}
+
+	if _, err := run("undo", "--json"); err != nil {
+		t.Fatalf("undo latest: %v", err)
+	}
+	if _, err := run("undo", "1", "--json"); err != nil {
+		t.Fatalf("undo 1: %v", err)
+	}
+	want := []string{`{"status":"resolved"}`, `{"assigned_user_id":321}`, `{"assigned_user_id":null}`, `{"status":"active"}`}
+	if strings.Join(patches, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected patches: %#v", patches)
+	}
+
+	if _, err := run("undo", "1"); err == nil || !strings.Contains(err.Error(), "already undone by entry 4") {
+		t.Fatalf("expected already undone error, got %v", err)
+	}
+	if _, err := run("undo"); err == nil || !strings.Contains(err.Error(), "no item changes to undo") {
+		t.Fatalf("expected nothing to undo, got %v", err)
+	}
+}
+
+func TestItemsResolveDryRunIsNotAudited(t *testing.T) {
+	var patches []string
+	ts := statefulItemServer(t, &patches)
+
+	if _, err := runCLIWithCapturedStdout(t, "items", "resolve", "42", "--dry-run", "--token", "tok", "--base-url", ts.URL); err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	entries, err := readAuditEntries()
+	if err != nil {
+		t.Fatalf("read audit log: %v", err)
+	}
+	if len(entries) != 0 || len(patches) != 0 {
+		t.Fatalf("dry run should not change or audit anything: %#v %#v", entries, patches)
+	}
+}
+
+func TestAppendAuditEntryNumbersAcrossWriters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	t.Setenv("ROLLBAR_CLI_AUDIT_LOG", path)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := appendAuditEntry(auditEntry{Source: auditSourceBulk, ItemID: 42}); err != nil {
+				t.Errorf("append: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Another run appends entry 40 and leaves an unparseable line behind the
+	// cached tail; only the new line is read.
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read audit log: %v", err)
+	}
+	data = append([]byte(strings.Repeat("x", bytes.IndexByte(data, '\n'))), data[bytes.IndexByte(data, '\n'):]...)
+	data = append(data, `{"entry":40,"source":"cli","item_id":7}`+"\n"...)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write audit log: %v", err)
+	}
+	if err := appendAuditEntry(auditEntry{Source: auditSourceCLI, ItemID: 42}); err != nil {
+		t.Fatalf("append after another writer: %v", err)
+	}
+
+	if data, err = os.ReadFile(path); err != nil {
+		t.Fatalf("read audit log: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	seen := make(map[int]bool)
+	for _, line := range lines[1:] {
+		var entry auditEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("invalid audit line %q: %v", line, err)
+		}
+		if seen[entry.Entry] {
+			t.Fatalf("duplicate entry number %d", entry.Entry)
+		}
+		seen[entry.Entry] = true
+	}
+	if len(lines) != 22 || !seen[20] || !seen[41] {
+		t.Fatalf("unexpected audit log:\n%s", strings.Join(lines, "\n"))
+	}
+
+	// A log that was truncated or replaced is read again from the start.
+	if err := os.WriteFile(path, []byte(`{"entry":3,"source":"cli","item_id":7}`+"\n"), 0o600); err != nil {
+		t.Fatalf("write audit log: %v", err)
+	}
+	if err := appendAuditEntry(auditEntry{Source: auditSourceCLI, ItemID: 42}); err != nil {
+		t.Fatalf("append after truncation: %v", err)
+	}
+	entries, err := readAuditEntries()
+	if err != nil || len(entries) != 2 || entries[1].Entry != 4 {
+		t.Fatalf("unexpected entries after truncation: %+v, %v", entries, err)
+	}
+}
+
+func TestInverseItemUpdate(t *testing.T) {
+	entry := auditEntry{
+		Entry:  3,
+		Before: &auditItemState{Title: "boom", Level: "error", Status: "muted", AssignedTeamID: 7},
+		Body:   map[string]any{"status": "resolved", "level": "info", "assigned_user_id": float64(1), "assigned_team_id": nil, "resolved_in_version": "abc"},
+	}
+	inverse, err := inverseItemUpdate(entry)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inverse) != 4 || inverse["status"] != "muted" || inverse["level"] != "error" || inverse["assigned_user_id"] != nil || inverse["assigned_team_id"] != int64(7) {
+		t.Fatalf("unexpected inverse: %#v", inverse)
+	}
+
+	_, err = inverseItemUpdate(auditEntry{Entry: 4, Before: &auditItemState{}, Body: map[string]any{"resolved_in_version": "abc"}})
+	if err == nil || !strings.Contains(err.Error(), "nothing undo can restore") {
+		t.Fatalf("expected nothing-to-restore error, got %v", err)
+	}
+}
@@ -1,13 +1,16 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
-	"sort"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
 	"github.com/davebarnwell/rollbar-cli/internal/ui"
@@ -15,7 +18,7 @@ import (
 
 type itemsListOptions struct {
 	Page        int
-	Pages       int
+	Pagination  paginationOptions
 	Status      string
 	Environment string
 	Level       []string
@@ -30,6 +33,13 @@ type itemsListOptions struct {
 	Since       string
 	Until       string
 	Last        time.Duration
+
+	AssignedUser   string
+	Unassigned     bool
+	Framework      string
+	Search         string
+	TitleMatch     string
+	MinOccurrences int64
 }
 
 type itemsGetOptions struct {
@@ -38,11 +48,13 @@ type itemsGetOptions struct {
 	Output          string
 	JSON            bool
 	RawJSON         bool
+	Fields          []string
 	WithInstances   bool
 	InstancesPage   int
 	PayloadMode     string
 	PayloadSections []string
 	MaxPayloadBytes int
+	Source          sourceOptions
 }
 
 type itemsUpdateOptions struct {
@@ -54,8 +66,10 @@ type itemsUpdateOptions struct {
 	Level                   string
 	AssignedUserID          int64
 	ClearAssignedUser       bool
+	User                    string
 	AssignedTeamID          int64
 	ClearAssignedTeam       bool
+	Team                    string
 	SnoozeEnabled           bool
 	SnoozeExpirationSeconds int
 	Output                  string
@@ -68,22 +82,27 @@ type itemsResolveOptions struct {
 	Output            string
 	JSON              bool
 	RawJSON           bool
+	Bulk              itemsBulkOptions
 }
 
 type itemsMuteOptions struct {
 	Output  string
 	JSON    bool
 	RawJSON bool
+	Bulk    itemsBulkOptions
 }
 
 type itemsAssignOptions struct {
 	AssignedUserID    int64
 	ClearAssignedUser bool
+	User              string
 	AssignedTeamID    int64
 	ClearAssignedTeam bool
+	Team              string
 	Output            string
 	JSON              bool
 	RawJSON           bool
+	Bulk              itemsBulkOptions
 }
 
 type itemsSnoozeOptions struct {
@@ -92,11 +111,7 @@ type itemsSnoozeOptions struct {
 	Output   string
 	JSON     bool
 	RawJSON  bool
-}
-
-type itemsWatchOptions struct {
-	Interval time.Duration
-	Count    int
+	Bulk     itemsBulkOptions
 }
 
 type itemGetJSONOutput struct {
@@ -105,7 +120,8 @@ type itemGetJSONOutput struct {
 }
 
 type itemListJSONOutput struct {
-	Items []rollbar.Item `json:"items"`
+	SchemaVersion int            `json:"schema_version"`
+	Items         []rollbar.Item `json:"items"`
 }
 
 func newItemsCmd(cfg *cliConfig) *cobra.Command {
@@ -145,7 +161,11 @@ func newItemsCmd(cfg *cliConfig) *cobra.Command {
 				return err
 			}
 
-			output, err := resolveOutputModeWithAliases(getOpts.Output, getOpts.JSON, getOpts.RawJSON, false, outputText, outputJSON, outputRawJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, getOpts.Output, getOpts.JSON, getOpts.RawJSON, false, outputText, outputJSON, outputRawJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+			sourceOpts, err := getOpts.Source.renderOptions(cfg)
 			if err != nil {
 				return err
 			}
@@ -158,9 +178,9 @@ func newItemsCmd(cfg *cliConfig) *cobra.Command {
 			switch output {
 			case outputRawJSON:
 				if instancesResp == nil {
-					return writeJSON(resp.Raw)
+					return writeJSON(cfg.Filter, resp.Raw)
 				}
-				return writeJSON(map[string]any{
+				return writeJSON(cfg.Filter, map[string]any{
 					"item":      resp.Raw,
 					"instances": instancesResp.Raw,
 				})
@@ -169,7 +189,9 @@ func newItemsCmd(cfg *cliConfig) *cobra.Command {
 				if instancesResp != nil {
 					out.Instances = instancesResp.Instances
 				}
-				return writeJSON(out)
+				return writeJSON(cfg.Filter, out)
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.ItemsTable([]rollbar.Item{resp.Item}, normalizeFields(getOpts.Fields)), false)
 			default:
 				payloadOpts := ui.PayloadRenderOptions{
 					Mode:            getOpts.PayloadMode,
@@ -179,6 +201,7 @@ func newItemsCmd(cfg *cliConfig) *cobra.Command {
 				if instancesResp != nil {
 					return ui.RenderItemWithInstancesOptions(resp.Item, instancesResp.Instances, ui.ItemDetailsRenderOptions{
 						Payload: payloadOpts,
+						Source:  sourceOpts,
 					})
 				}
 				return ui.RenderItemWithOptions(resp.Item, ui.ItemDetailsRenderOptions{Payload: payloadOpts})
@@ -195,12 +218,33 @@ func newItemsCmd(cfg *cliConfig) *cobra.Command {
 				return err
 			}
 
+			if err := validateUserFlag(cmd, updateOpts.ClearAssignedUser); err != nil {
+				return err
+			}
+			if err := validateTeamFlag(cmd, updateOpts.ClearAssignedTeam); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("user") {
+				userID, err := resolveUserFlag(cmd, cfg, updateOpts.User)
+				if err != nil {
+					return err
+				}
+				updateOpts.AssignedUserID = userID
+			}
+			if cmd.Flags().Changed("team") {
+				teamID, err := resolveTeamFlag(cmd, cfg, updateOpts.Team)
+				if err != nil {
+					return err
+				}
+				updateOpts.AssignedTeamID = teamID
+			}
+
 			body, err := buildItemUpdateBody(cmd, updateOpts)
 			if err != nil {
 				return err
 			}
 
-			output, err := resolveOutputModeWithAliases(updateOpts.Output, updateOpts.JSON, updateOpts.RawJSON, false, outputText, outputJSON, outputRawJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, updateOpts.Output, updateOpts.JSON, updateOpts.RawJSON, false, outputText, outputJSON, outputRawJSON)
 			if err != nil {
 				return err
 			}
@@ -221,11 +265,11 @@ func newItemsCmd(cfg *cliConfig) *cobra.Command {
 			if version := strings.TrimSpace(resolveOpts.ResolvedInVersion); version != "" {
 				body["resolved_in_version"] = version
 			}
-			output, err := resolveOutputModeWithAliases(resolveOpts.Output, resolveOpts.JSON, resolveOpts.RawJSON, false, outputText, outputJSON, outputRawJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, resolveOpts.Output, resolveOpts.JSON, resolveOpts.RawJSON, false, outputText, outputJSON, outputRawJSON)
 			if err != nil {
 				return err
 			}
-			return executeItemUpdate(cmd, cfg, args, body, output, 0, "")
+			return executeItemAction(cmd, cfg, args, body, output, resolveOpts.Bulk)
 		},
 	}
 
@@ -237,11 +281,11 @@ func newItemsCmd(cfg *cliConfig) *cobra.Command {
 			if err := requireToken(cfg); err != nil {
 				return err
 			}
-			output, err := resolveOutputModeWithAliases(muteOpts.Output, muteOpts.JSON, muteOpts.RawJSON, false, outputText, outputJSON, outputRawJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, muteOpts.Output, muteOpts.JSON, muteOpts.RawJSON, false, outputText, outputJSON, outputRawJSON)
 			if err != nil {
 				return err
 			}
-			return executeItemUpdate(cmd, cfg, args, map[string]any{"status": "muted"}, output, 0, "")
+			return executeItemAction(cmd, cfg, args, map[string]any{"status": "muted"}, output, muteOpts.Bulk)
 		},
 	}
 
@@ -254,6 +298,27 @@ func newItemsCmd(cfg *cliConfig) *cobra.Command {
 				return err
 			}
 
+			if err := validateUserFlag(cmd, assignOpts.ClearAssignedUser); err != nil {
+				return err
+			}
+			if err := validateTeamFlag(cmd, assignOpts.ClearAssignedTeam); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("user") {
+				userID, err := resolveUserFlag(cmd, cfg, assignOpts.User)
+				if err != nil {
+					return err
+				}
+				assignOpts.AssignedUserID = userID
+			}
+			if cmd.Flags().Changed("team") {
+				teamID, err := resolveTeamFlag(cmd, cfg, assignOpts.Team)
+				if err != nil {
+					return err
+				}
+				assignOpts.AssignedTeamID = teamID
+			}
+
 			body := make(map[string]any)
 			if assignOpts.ClearAssignedUser && assignOpts.AssignedUserID > 0 {
 				return fmt.Errorf("use either --assigned-user-id or --clear-assigned-user, not both")
@@ -277,11 +342,11 @@ func newItemsCmd(cfg *cliConfig) *cobra.Command {
 				return fmt.Errorf("no assignment changes provided")
 			}
 
-			output, err := resolveOutputModeWithAliases(assignOpts.Output, assignOpts.JSON, assignOpts.RawJSON, false, outputText, outputJSON, outputRawJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, assignOpts.Output, assignOpts.JSON, assignOpts.RawJSON, false, outputText, outputJSON, outputRawJSON)
 			if err != nil {
 				return err
 			}
-			return executeItemUpdate(cmd, cfg, args, body, output, 0, "")
+			return executeItemAction(cmd, cfg, args, body, output, assignOpts.Bulk)
 		},
 	}
 
@@ -305,126 +370,130 @@ func newItemsCmd(cfg *cliConfig) *cobra.Command {
 				return fmt.Errorf("set either --duration or --disable")
 			}
 
-			output, err := resolveOutputModeWithAliases(snoozeOpts.Output, snoozeOpts.JSON, snoozeOpts.RawJSON, false, outputText, outputJSON, outputRawJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, snoozeOpts.Output, snoozeOpts.JSON, snoozeOpts.RawJSON, false, outputText, outputJSON, outputRawJSON)
 			if err != nil {
 				return err
 			}
-			return executeItemUpdate(cmd, cfg, args, body, output, 0, "")
+			return executeItemAction(cmd, cfg, args, body, output, snoozeOpts.Bulk)
 		},
 	}
 
 	watchCmd := &cobra.Command{
 		Use:   "watch",
-		Short: "Poll the item list on an interval",
+		Short: "Poll the item list on an interval, or report only what changed",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireToken(cfg); err != nil {
 				return err
 			}
-			if watchOpts.Interval <= 0 {
-				return fmt.Errorf("--interval must be > 0")
-			}
-			if watchOpts.Count <= 0 {
-				return fmt.Errorf("--count must be > 0")
-			}
-
-			for i := 0; i < watchOpts.Count; i++ {
-				if i > 0 {
-					if err := writeStdoutf("\n[%s]\n", time.Now().UTC().Format(time.RFC3339)); err != nil {
-						return err
-					}
-				}
-				if err := runItemsList(cmd, cfg, prepareWatchListOptions(listOpts)); err != nil {
-					return err
-				}
-				if i+1 < watchOpts.Count {
-					select {
-					case <-cmd.Context().Done():
-						return cmd.Context().Err()
-					case <-time.After(watchOpts.Interval):
-					}
-				}
-			}
-			return nil
+			return runItemsWatch(cmd, cfg, listOpts, watchOpts)
 		},
 	}
 
 	listCmd.Flags().IntVar(&listOpts.Page, "page", 1, "Starting page number")
-	listCmd.Flags().IntVar(&listOpts.Pages, "pages", 1, "Number of pages to fetch")
+	addPaginationFlags(listCmd.Flags(), &listOpts.Pagination, 1)
+	listCmd.Flags().SetNormalizeFunc(normalizePagesFlag)
 	listCmd.Flags().StringVar(&listOpts.Status, "status", "", "Filter by item status")
 	listCmd.Flags().StringVar(&listOpts.Environment, "environment", "", "Filter by environment")
 	listCmd.Flags().StringSliceVar(&listOpts.Level, "level", nil, "Filter by level; pass multiple times for multiple levels")
-	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson")
+	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
 	listCmd.Flags().BoolVar(&listOpts.JSON, "json", false, "Shortcut for --output json")
 	listCmd.Flags().BoolVar(&listOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
 	listCmd.Flags().BoolVar(&listOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
-	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text output")
-	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text output")
-	listCmd.Flags().StringVar(&listOpts.Sort, "sort", "last_seen_desc", "Sort order: last_seen_desc|last_seen_asc|counter_desc|counter_asc|title|level")
+	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text, csv, tsv, yaml, and markdown output")
+	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
+	listCmd.Flags().StringVar(&listOpts.Sort, "sort", "last_seen_desc", "Comma-separated sort keys, each ascending unless prefixed with - (e.g. level,-total_occurrences): last_seen|first_seen|total_occurrences|counter|id|level|environment|status|title")
 	listCmd.Flags().IntVar(&listOpts.Limit, "limit", 0, "Maximum number of items to return after filtering")
 	listCmd.Flags().StringVar(&listOpts.Since, "since", "", "Only include items seen at or after this time")
 	listCmd.Flags().StringVar(&listOpts.Until, "until", "", "Only include items seen at or before this time")
 	listCmd.Flags().DurationVar(&listOpts.Last, "last", 0, "Only include items seen within this duration")
+	listCmd.Flags().StringVar(&listOpts.AssignedUser, "assigned-user", "", "Only include items assigned to this username")
+	listCmd.Flags().BoolVar(&listOpts.Unassigned, "unassigned", false, "Only include items with no assigned user")
+	listCmd.Flags().StringVar(&listOpts.Framework, "framework", "", "Filter by framework")
+	listCmd.Flags().StringVar(&listOpts.Search, "search", "", "Filter with Rollbar's item search, as typed in the web UI search box")
+	listCmd.Flags().StringVar(&listOpts.TitleMatch, "title-match", "", "Only include items whose title matches this regular expression")
+	listCmd.Flags().Int64Var(&listOpts.MinOccurrences, "min-occurrences", 0, "Only include items with at least this many occurrences")
 
 	getCmd.Flags().Int64Var(&getOpts.ID, "id", 0, "Item ID")
 	getCmd.Flags().StringVar(&getOpts.UUID, "uuid", "", "Item UUID")
-	getCmd.Flags().StringVarP(&getOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json")
+	addItemCounterFlag(getCmd, "counter")
+	getCmd.Flags().StringVarP(&getOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|csv|tsv|yaml|markdown|template")
 	getCmd.Flags().BoolVar(&getOpts.JSON, "json", false, "Shortcut for --output json")
 	getCmd.Flags().BoolVar(&getOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	getCmd.Flags().StringSliceVar(&getOpts.Fields, "fields", nil, "Fields to render in csv, tsv, yaml, and markdown output")
 	getCmd.Flags().BoolVar(&getOpts.WithInstances, "instances", false, "Include item instances")
 	getCmd.Flags().IntVar(&getOpts.InstancesPage, "instances-page", 1, "Instances page to fetch when --instances is set")
 	getCmd.Flags().StringVar(&getOpts.PayloadMode, "payload", "summary", "Payload mode for text output: none|summary|full")
 	getCmd.Flags().StringSliceVar(&getOpts.PayloadSections, "payload-section", nil, "Payload sections to include")
 	getCmd.Flags().IntVar(&getOpts.MaxPayloadBytes, "max-payload-bytes", 4096, "Maximum payload size to render in text output")
+	addSourceFlags(getCmd.Flags(), &getOpts.Source)
 
 	updateCmd.Flags().Int64Var(&updateOpts.ID, "id", 0, "Item ID")
 	updateCmd.Flags().StringVar(&updateOpts.UUID, "uuid", "", "Item UUID")
+	addItemCounterFlag(updateCmd, "counter")
 	updateCmd.Flags().StringVar(&updateOpts.Status, "status", "", "New status: active|resolved|muted")
 	updateCmd.Flags().StringVar(&updateOpts.ResolvedInVersion, "resolved-in-version", "", "Resolved version (max 40 chars)")
 	updateCmd.Flags().StringVar(&updateOpts.Title, "title", "", "New title (1-255 chars)")
 	updateCmd.Flags().StringVar(&updateOpts.Level, "level", "", "New level: critical|error|warning|info|debug")
 	updateCmd.Flags().Int64Var(&updateOpts.AssignedUserID, "assigned-user-id", 0, "Assign to user ID")
 	updateCmd.Flags().BoolVar(&updateOpts.ClearAssignedUser, "clear-assigned-user", false, "Clear assigned user")
+	updateCmd.Flags().StringVar(&updateOpts.User, "user", "", "Assign to user by username, email, or ID")
 	updateCmd.Flags().Int64Var(&updateOpts.AssignedTeamID, "assigned-team-id", 0, "Assign to team ID")
 	updateCmd.Flags().BoolVar(&updateOpts.ClearAssignedTeam, "clear-assigned-team", false, "Clear assigned team")
+	updateCmd.Flags().StringVar(&updateOpts.Team, "team", "", "Assign to team by name or ID (resolved via the teams API)")
 	updateCmd.Flags().BoolVar(&updateOpts.SnoozeEnabled, "snooze-enabled", false, "Set snooze enabled state")
 	updateCmd.Flags().IntVar(&updateOpts.SnoozeExpirationSeconds, "snooze-expiration-seconds", 0, "Snooze expiration in seconds")
-	updateCmd.Flags().StringVarP(&updateOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json")
+	updateCmd.Flags().StringVarP(&updateOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|template")
 	updateCmd.Flags().BoolVar(&updateOpts.JSON, "json", false, "Shortcut for --output json")
 	updateCmd.Flags().BoolVar(&updateOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
 
+	addItemCounterFlag(resolveCmd, "counter")
 	resolveCmd.Flags().StringVar(&resolveOpts.ResolvedInVersion, "resolved-in-version", "", "Resolved version")
-	resolveCmd.Flags().StringVarP(&resolveOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json")
+	resolveCmd.Flags().StringVarP(&resolveOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|template")
 	resolveCmd.Flags().BoolVar(&resolveOpts.JSON, "json", false, "Shortcut for --output json")
 	resolveCmd.Flags().BoolVar(&resolveOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	addBulkFlags(resolveCmd, &resolveOpts.Bulk)
 
-	muteCmd.Flags().StringVarP(&muteOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json")
+	addItemCounterFlag(muteCmd, "counter")
+	muteCmd.Flags().StringVarP(&muteOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|template")
 	muteCmd.Flags().BoolVar(&muteOpts.JSON, "json", false, "Shortcut for --output json")
 	muteCmd.Flags().BoolVar(&muteOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	addBulkFlags(muteCmd, &muteOpts.Bulk)
 
+	addItemCounterFlag(assignCmd, "counter")
 	assignCmd.Flags().Int64Var(&assignOpts.AssignedUserID, "assigned-user-id", 0, "Assign to user ID")
 	assignCmd.Flags().BoolVar(&assignOpts.ClearAssignedUser, "clear-assigned-user", false, "Clear assigned user")
+	assignCmd.Flags().StringVar(&assignOpts.User, "user", "", "Assign to user by username, email, or ID")
 	assignCmd.Flags().Int64Var(&assignOpts.AssignedTeamID, "assigned-team-id", 0, "Assign to team ID")
 	assignCmd.Flags().BoolVar(&assignOpts.ClearAssignedTeam, "clear-assigned-team", false, "Clear assigned team")
-	assignCmd.Flags().StringVarP(&assignOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json")
+	assignCmd.Flags().StringVar(&assignOpts.Team, "team", "", "Assign to team by name or ID (resolved via the teams API)")
+	assignCmd.Flags().StringVarP(&assignOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|template")
 	assignCmd.Flags().BoolVar(&assignOpts.JSON, "json", false, "Shortcut for --output json")
 	assignCmd.Flags().BoolVar(&assignOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	addBulkFlags(assignCmd, &assignOpts.Bulk)
 
+	addItemCounterFlag(snoozeCmd, "counter")
 	snoozeCmd.Flags().DurationVar(&snoozeOpts.Duration, "duration", 0, "How long to snooze the item")
 	snoozeCmd.Flags().BoolVar(&snoozeOpts.Disable, "disable", false, "Disable snooze")
-	snoozeCmd.Flags().StringVarP(&snoozeOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json")
+	snoozeCmd.Flags().StringVarP(&snoozeOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|template")
 	snoozeCmd.Flags().BoolVar(&snoozeOpts.JSON, "json", false, "Shortcut for --output json")
 	snoozeCmd.Flags().BoolVar(&snoozeOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	addBulkFlags(snoozeCmd, &snoozeOpts.Bulk)
 
 	watchCmd.Flags().AddFlagSet(listCmd.Flags())
+	watchCmd.Flags().SetNormalizeFunc(normalizePagesFlag)
 	watchCmd.Flags().DurationVar(&watchOpts.Interval, "interval", 30*time.Second, "Polling interval")
-	watchCmd.Flags().IntVar(&watchOpts.Count, "count", 1, "Number of polls to run")
+	watchCmd.Flags().IntVar(&watchOpts.Count, "count", 1, "Number of polls to run; 0 polls until interrupted (the default with --changes)")
+	watchCmd.Flags().BoolVar(&watchOpts.Changes, "changes", false, "Print only new, reactivated, and escalated items and occurrence jumps since the previous poll")
+	watchCmd.Flags().Int64Var(&watchOpts.MinDelta, "min-delta", defaultWatchMinDelta, "Smallest occurrence increase between polls to report (implies --changes)")
+	watchCmd.Flags().StringVar(&watchOpts.Exec, "exec", "", "Shell command to run for each change, with the event as JSON on stdin (implies --changes)")
+	watchCmd.Flags().BoolVar(&watchOpts.Notify, "notify", false, "Show a desktop notification for each change (implies --changes)")
 
 	itemsCmd.AddCommand(listCmd, getCmd, updateCmd, resolveCmd, muteCmd, assignCmd, snoozeCmd, watchCmd)
 	return itemsCmd
 }
 
 func runItemsList(cmd *cobra.Command, cfg *cliConfig, opts itemsListOptions) error {
-	output, err := resolveOutputModeWithAliases(opts.Output, opts.JSON, opts.RawJSON, opts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+	output, err := resolveOutputModeWithAliases(cfg.Filter, opts.Output, opts.JSON, opts.RawJSON, opts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
 	if err != nil {
 		return err
 	}
@@ -436,17 +505,20 @@ func runItemsList(cmd *cobra.Command, cfg *cliConfig, opts itemsListOptions) err
 
 	switch output {
 	case outputRawJSON:
-		return writeJSON(raw)
+		return writeJSON(cfg.Filter, raw)
 	case outputJSON:
-		return writeJSON(itemListJSONOutput{Items: items})
+		return writeJSON(cfg.Filter, itemListJSONOutput{SchemaVersion: jsonSchemaVersion, Items: items})
 	case outputNDJSON:
 		records := make([]any, 0, len(items))
 		for _, item := range items {
 			records = append(records, item)
 		}
-		return writeNDJSON(records)
+		return writeNDJSON(cfg.Filter, records)
+	case outputCSV, outputTSV, outputYAML, outputMarkdown:
+		return writeTable(output, ui.ItemsTable(items, normalizeFields(opts.Fields)), opts.NoHeaders)
 	default:
 		client := newRollbarClient(cfg)
+		users := newUserDirectory(cfg)
 		return ui.RenderItemsWithOptions(items, ui.ItemListRenderOptions{
 			Fields:    normalizeFields(opts.Fields),
 			NoHeaders: opts.NoHeaders,
@@ -460,18 +532,28 @@ func runItemsList(cmd *cobra.Command, cfg *cliConfig, opts itemsListOptions) err
 					return resp.Instances, nil
 				},
 				ResolveItem: func(item rollbar.Item) (rollbar.Item, error) {
-					return updateItemForTUI(cmd, client, item.ID, map[string]any{"status": "resolved"})
+					return updateItemForTUI(cmd, cfg, client, item.ID, map[string]any{"status": "resolved"})
 				},
 				MuteItem: func(item rollbar.Item) (rollbar.Item, error) {
-					return updateItemForTUI(cmd, client, item.ID, map[string]any{"status": "muted"})
+					return updateItemForTUI(cmd, cfg, client, item.ID, map[string]any{"status": "muted"})
+				},
+				AssignItem: func(item rollbar.Item, user string) (rollbar.Item, error) {
+					resolved, err := users.Resolve(cmd.Context(), user)
+					if err != nil {
+						return item, err
+					}
+					return updateItemForTUI(cmd, cfg, client, item.ID, map[string]any{"assigned_user_id": resolved.ID})
+				},
+				OpenItem: func(item rollbar.Item) (string, error) {
+					return openItemInBrowser(cfg.ProjectSlug, item)
 				},
 			},
 		})
 	}
 }
 
-func prepareWatchListOptions(opts itemsListOptions) itemsListOptions {
-	output, err := resolveOutputModeWithAliases(opts.Output, opts.JSON, opts.RawJSON, opts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+func prepareWatchListOptions(filter outputFilter, opts itemsListOptions) itemsListOptions {
+	output, err := resolveOutputModeWithAliases(filter, opts.Output, opts.JSON, opts.RawJSON, opts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
 	if err != nil {
 		return opts
 	}
@@ -484,60 +566,59 @@ func prepareWatchListOptions(opts itemsListOptions) itemsListOptions {
 	return opts
 }
 
-func updateItemForTUI(cmd *cobra.Command, client *rollbar.Client, id int64, body map[string]any) (rollbar.Item, error) {
-	resp, err := client.UpdateItemByID(cmd.Context(), id, body)
+func updateItemForTUI(cmd *cobra.Command, cfg *cliConfig, client *rollbar.Client, id int64, body map[string]any) (rollbar.Item, error) {
+	before, err := client.GetItemByID(cmd.Context(), id)
 	if err != nil {
 		return rollbar.Item{}, err
 	}
-	if resp.Item.ID > 0 {
-		return resp.Item, nil
-	}
-	getResp, err := client.GetItemByID(cmd.Context(), id)
+	resp, err := updateItemAudited(cmd, cfg, client, id, before, body, auditEntry{Source: auditSourceTUI})
 	if err != nil {
 		return rollbar.Item{}, err
 	}
-	return getResp.Item, nil
+	if resp.Item.ID <= 0 {
+		return rollbar.Item{}, fmt.Errorf("item %d was updated but could not be reloaded", id)
+	}
+	return resp.Item, nil
 }
 
 func collectAndShapeItems(cmd *cobra.Command, cfg *cliConfig, opts itemsListOptions) ([]rollbar.Item, map[string]any, error) {
-	if opts.Pages <= 0 {
-		opts.Pages = 1
-	}
 	if opts.Limit < 0 {
 		return nil, nil, fmt.Errorf("--limit must be >= 0")
 	}
+	pagerOpts, err := opts.Pagination.pagerOptions(opts.Page)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	client := newRollbarClient(cfg)
-	startPage := opts.Page
-	if startPage <= 0 {
-		startPage = 1
-	}
-
-	items := make([]rollbar.Item, 0)
-	rawPages := make([]map[string]any, 0, opts.Pages)
-	for pageOffset := 0; pageOffset < opts.Pages; pageOffset++ {
-		resp, err := client.ListItems(cmd.Context(), rollbar.ListItemsOptions{
-			Page:        startPage + pageOffset,
-			Status:      opts.Status,
-			Environment: opts.Environment,
-			Level:       opts.Level,
-		})
-		if err != nil {
-			return nil, nil, err
-		}
-		items = append(items, resp.Items...)
-		rawPages = append(rawPages, resp.Raw)
-		if len(resp.Items) == 0 {
-			break
-		}
+	listOpts, err := itemsListAPIOptions(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	compareItems, err := parseItemSort(opts.Sort)
+	if err != nil {
+		return nil, nil, err
+	}
+	filter, err := newItemFilter(opts)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	since, until, err := parseItemTimeRange(opts)
+	// Client-side filters can leave a page short, so unless the page count was
+	// pinned, keep fetching until --limit items match or the list runs out.
+	stopAt := 0
+	if filter.active() && opts.Limit > 0 && !opts.Pagination.All && !cmd.Flags().Changed("max-pages") {
+		pagerOpts.MaxPages = 0
+		stopAt = opts.Limit
+	}
+
+	client := newRollbarClient(cfg)
+	pager := client.ItemsPager(listOpts, pagerOpts)
+	items, rawPages, err := collectFilteredPages(cmd.Context(), pager, filter.keep, stopAt)
 	if err != nil {
 		return nil, nil, err
 	}
-	items = filterItemsByTime(items, since, until)
-	sortItemsWithDirection(items, opts.Sort)
+
+	slices.SortStableFunc(items, compareItems)
 	if opts.Limit > 0 && len(items) > opts.Limit {
 		items = items[:opts.Limit]
 	}
@@ -546,24 +627,13 @@ func collectAndShapeItems(cmd *cobra.Command, cfg *cliConfig, opts itemsListOpti
 }
 
 func getItemAndInstances(cmd *cobra.Command, cfg *cliConfig, args []string, opts itemsGetOptions) (*rollbar.GetItemResponse, *rollbar.ListItemInstancesResponse, error) {
-	idSet := cmd.Flags().Changed("id")
-	uuidSet := cmd.Flags().Changed("uuid")
-	arg := ""
-	if len(args) > 0 {
-		arg = args[0]
-	}
-	id, uuid, err := resolveIdentifierValue(arg, opts.ID, opts.UUID, idSet, uuidSet, "item", "[id-or-uuid]", "--id", "--uuid")
+	ref, err := resolveItemIdentifier(cmd, args, opts.ID, opts.UUID, "")
 	if err != nil {
 		return nil, nil, err
 	}
 
 	client := newRollbarClient(cfg)
-	var resp *rollbar.GetItemResponse
-	if uuid != "" {
-		resp, err = client.GetItemByUUID(cmd.Context(), uuid)
-	} else {
-		resp, err = client.GetItemByID(cmd.Context(), id)
-	}
+	resp, err := getItemByRef(cmd.Context(), client, ref)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -572,12 +642,12 @@ func getItemAndInstances(cmd *cobra.Command, cfg *cliConfig, args []string, opts
 		return resp, nil, nil
 	}
 
-	instanceIdentifier := uuid
+	instanceIdentifier := ref.UUID
 	if resp.Item.ID > 0 {
 		instanceIdentifier = strconv.FormatInt(resp.Item.ID, 10)
 	}
 	if instanceIdentifier == "" {
-		instanceIdentifier = strconv.FormatInt(id, 10)
+		instanceIdentifier = strconv.FormatInt(ref.ID, 10)
 	}
 	instancesResp, err := client.ListItemInstances(cmd.Context(), instanceIdentifier, opts.InstancesPage)
 	if err != nil {
@@ -587,39 +657,49 @@ func getItemAndInstances(cmd *cobra.Command, cfg *cliConfig, args []string, opts
 }
 
 func executeItemUpdate(cmd *cobra.Command, cfg *cliConfig, args []string, body map[string]any, output string, explicitID int64, explicitUUID string) error {
-	idSet := explicitID > 0 || cmd.Flags().Changed("id")
-	uuidSet := explicitUUID != "" || cmd.Flags().Changed("uuid")
-	arg := ""
-	if len(args) > 0 {
-		arg = args[0]
-	}
-	id, uuid, err := resolveIdentifierValue(arg, explicitID, explicitUUID, idSet, uuidSet, "item", "[id-or-uuid]", "--id", "--uuid")
+	ref, err := resolveItemIdentifier(cmd, args, explicitID, explicitUUID, "")
 	if err != nil {
 		return err
 	}
 
+	// Fetch the target first so a mistyped ID fails before anything changes
+	// and production items can be confirmed.
 	client := newRollbarClient(cfg)
-	if uuid != "" {
-		getResp, err := client.GetItemByUUID(cmd.Context(), uuid)
-		if err != nil {
-			return err
+	current, err := getItemByRef(cmd.Context(), client, ref)
+	if err != nil {
+		return err
+	}
+	id := ref.ID
+	if id == 0 {
+		if current.Item.ID <= 0 {
+			return fmt.Errorf("could not resolve %s to a valid item id", ref)
 		}
-		if getResp.Item.ID <= 0 {
-			return fmt.Errorf("could not resolve UUID %q to a valid item id", uuid)
+		id = current.Item.ID
+	}
+	if isProductionEnvironment(current.Item.Environment) {
+		action := fmt.Sprintf("%s item %d in %s", itemActionVerb(body), id, current.Item.Environment)
+		if err := confirmAction(cmd, cfg, action); err != nil {
+			return err
 		}
-		id = getResp.Item.ID
 	}
 
-	updateResp, err := client.UpdateItemByID(cmd.Context(), id, body)
+	updateResp, err := updateItemAudited(cmd, cfg, client, id, current, body, auditEntry{Source: auditSourceCLI})
+	var plan *rollbar.DryRunError
+	if errors.As(err, &plan) {
+		return writeDryRunPlan(cfg.Filter, plan, &current.Item, output != outputText)
+	}
 	if err != nil {
 		return err
 	}
+	return writeItemUpdateResult(cmd, cfg.Filter, client, id, updateResp, output)
+}
 
+func writeItemUpdateResult(cmd *cobra.Command, filter outputFilter, client *rollbar.Client, id int64, updateResp *rollbar.UpdateItemResponse, output string) error {
 	switch output {
 	case outputRawJSON:
-		return writeJSON(updateResp.Raw)
+		return writeJSON(filter, updateResp.Raw)
 	case outputJSON:
-		return writeJSON(itemGetJSONOutput{Item: updateResp.Item})
+		return writeJSON(filter, itemGetJSONOutput{Item: updateResp.Item})
 	default:
 		if updateResp.Item.ID > 0 {
 			return ui.RenderItem(updateResp.Item)
@@ -676,7 +756,8 @@ func buildItemUpdateBody(cmd *cobra.Command, opts itemsUpdateOptions) (map[strin
 	if opts.ClearAssignedUser {
 		body["assigned_user_id"] = nil
 	}
-	if cmd.Flags().Changed("assigned-user-id") {
+	// --user and --team are resolved to IDs by the caller before the body is built.
+	if cmd.Flags().Changed("assigned-user-id") || cmd.Flags().Changed("user") {
 		if opts.AssignedUserID <= 0 {
 			return nil, fmt.Errorf("--assigned-user-id must be > 0")
 		}
@@ -689,7 +770,7 @@ func buildItemUpdateBody(cmd *cobra.Command, opts itemsUpdateOptions) (map[strin
 	if opts.ClearAssignedTeam {
 		body["assigned_team_id"] = nil
 	}
-	if cmd.Flags().Changed("assigned-team-id") {
+	if cmd.Flags().Changed("assigned-team-id") || cmd.Flags().Changed("team") {
 		if opts.AssignedTeamID <= 0 {
 			return nil, fmt.Errorf("--assigned-team-id must be > 0")
 		}
@@ -713,7 +794,11 @@ func buildItemUpdateBody(cmd *cobra.Command, opts itemsUpdateOptions) (map[strin
 }
 
 func parseItemTimeRange(opts itemsListOptions) (time.Time, time.Time, error) {
-	if opts.Last > 0 && strings.TrimSpace(opts.Since) != "" {
+	return parseTimeRange(opts.Since, opts.Until, opts.Last)
+}
+
+func parseTimeRange(sinceValue string, untilValue string, last time.Duration) (time.Time, time.Time, error) {
+	if last > 0 && strings.TrimSpace(sinceValue) != "" {
 		return time.Time{}, time.Time{}, fmt.Errorf("use either --last or --since, not both")
 	}
 
@@ -721,57 +806,90 @@ func parseItemTimeRange(opts itemsListOptions) (time.Time, time.Time, error) {
 	var until time.Time
 	var err error
 
-	if strings.TrimSpace(opts.Since) != "" {
-		since, err = parseTimeFilter(opts.Since)
+	if strings.TrimSpace(sinceValue) != "" {
+		since, err = parseTimeFilter(sinceValue)
 		if err != nil {
 			return time.Time{}, time.Time{}, fmt.Errorf("parse --since: %w", err)
 		}
 	}
-	if strings.TrimSpace(opts.Until) != "" {
-		until, err = parseTimeFilter(opts.Until)
+	if strings.TrimSpace(untilValue) != "" {
+		until, err = parseTimeFilter(untilValue)
 		if err != nil {
 			return time.Time{}, time.Time{}, fmt.Errorf("parse --until: %w", err)
 		}
 	}
-	if opts.Last > 0 {
-		since = time.Now().UTC().Add(-opts.Last)
+	if last > 0 {
+		since = time.Now().UTC().Add(-last)
 	}
 	return since, until, nil
 }
 
-func filterItemsByTime(items []rollbar.Item, since time.Time, until time.Time) []rollbar.Item {
-	return filterItems(items, since, until, 0)
+// itemsListAPIOptions maps the filters the items endpoint supports onto its
+// query parameters.
+func itemsListAPIOptions(opts itemsListOptions) (rollbar.ListItemsOptions, error) {
+	assignedUser := strings.TrimSpace(opts.AssignedUser)
+	if opts.Unassigned {
+		if assignedUser != "" {
+			return rollbar.ListItemsOptions{}, fmt.Errorf("use either --assigned-user or --unassigned, not both")
+		}
+		assignedUser = "unassigned"
+	}
+	return rollbar.ListItemsOptions{
+		Status:       opts.Status,
+		Environment:  opts.Environment,
+		Level:        opts.Level,
+		AssignedUser: assignedUser,
+		Framework:    strings.TrimSpace(opts.Framework),
+		Query:        strings.TrimSpace(opts.Search),
+	}, nil
 }
 
-func sortItemsWithDirection(items []rollbar.Item, sortBy string) {
-	switch strings.TrimSpace(strings.ToLower(sortBy)) {
-	case "", "last_seen_desc":
-		sort.SliceStable(items, func(i, j int) bool {
-			return items[i].LastOccurrenceTimestamp > items[j].LastOccurrenceTimestamp
-		})
-	case "last_seen_asc":
-		sort.SliceStable(items, func(i, j int) bool {
-			return items[i].LastOccurrenceTimestamp < items[j].LastOccurrenceTimestamp
-		})
-	case "counter_desc":
-		sort.SliceStable(items, func(i, j int) bool {
-			return items[i].Counter > items[j].Counter
-		})
-	case "counter_asc":
-		sort.SliceStable(items, func(i, j int) bool {
-			return items[i].Counter < items[j].Counter
-		})
-	case "title":
-		sort.SliceStable(items, func(i, j int) bool {
-			return strings.ToLower(items[i].Title) < strings.ToLower(items[j].Title)
-		})
-	case "level":
-		sort.SliceStable(items, func(i, j int) bool {
-			return strings.ToLower(items[i].Level) < strings.ToLower(items[j].Level)
-		})
-	default:
-		sortItems(items, "")
+// itemFilter applies the item list filters the API cannot.
+type itemFilter struct {
+	since          time.Time
+	until          time.Time
+	titleMatch     *regexp.Regexp
+	minOccurrences int64
+}
+
+func newItemFilter(opts itemsListOptions) (itemFilter, error) {
+	since, until, err := parseItemTimeRange(opts)
+	if err != nil {
+		return itemFilter{}, err
+	}
+	if opts.MinOccurrences < 0 {
+		return itemFilter{}, fmt.Errorf("--min-occurrences must be >= 0")
+	}
+	filter := itemFilter{since: since, until: until, minOccurrences: opts.MinOccurrences}
+	if pattern := strings.TrimSpace(opts.TitleMatch); pattern != "" {
+		filter.titleMatch, err = regexp.Compile(pattern)
+		if err != nil {
+			return itemFilter{}, fmt.Errorf("parse --title-match: %w", err)
+		}
+	}
+	return filter, nil
+}
+
+func (f itemFilter) active() bool {
+	return !f.since.IsZero() || !f.until.IsZero() || f.titleMatch != nil || f.minOccurrences > 0
+}
+
+func (f itemFilter) keep(item rollbar.Item) bool {
+	if !itemInTimeRange(item, f.since, f.until) {
+		return false
+	}
+	if f.titleMatch != nil && !f.titleMatch.MatchString(item.Title) {
+		return false
+	}
+	return item.TotalOccurrences >= f.minOccurrences
+}
+
+// normalizePagesFlag keeps the original --pages flag working as an alias of --max-pages.
+func normalizePagesFlag(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+	if name == "pages" {
+		name = "max-pages"
 	}
+	return pflag.NormalizedName(name)
 }
 
 func normalizeFields(fields []string) []string {
@@ -0,0 +1,330 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+const defaultBulkConcurrency = 4
+
+// itemsBulkOptions selects many items for resolve/mute/assign/snooze, either
+// from IDs piped on stdin or from the same filters that `items list` takes.
+type itemsBulkOptions struct {
+	Stdin       bool
+	Status      string
+	Environment string
+	Level       []string
+	Last        time.Duration
+	Concurrency int
+}
+
+type bulkItemResult struct {
+	ID      int64          `json:"id"`
+	Counter int64          `json:"counter,omitempty"`
+	OK      bool           `json:"ok"`
+	Error   string         `json:"error,omitempty"`
+	Item    *rollbar.Item  `json:"item,omitempty"`
+	Raw     map[string]any `json:"raw,omitempty"`
+}
+
+type bulkItemReport struct {
+	DryRun    bool             `json:"dry_run"`
+	Body      map[string]any   `json:"body"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Results   []bulkItemResult `json:"results"`
+}
+
+func addBulkFlags(cmd *cobra.Command, opts *itemsBulkOptions) {
+	cmd.Flags().BoolVar(&opts.Stdin, "stdin", false, "Read item IDs, #counters, or NDJSON item records (as printed by items list --ndjson) from stdin")
+	cmd.Flags().StringVar(&opts.Status, "status", "", "Bulk: select items with this status")
+	cmd.Flags().StringVar(&opts.Environment, "environment", "", "Bulk: select items in this environment")
+	cmd.Flags().StringSliceVar(&opts.Level, "level", nil, "Bulk: select items with these levels")
+	cmd.Flags().DurationVar(&opts.Last, "last", 0, "Bulk: select items seen within this duration")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", defaultBulkConcurrency, "Bulk: maximum number of concurrent updates")
+}
+
+func (o itemsBulkOptions) enabled(cmd *cobra.Command) bool {
+	if o.Stdin {
+		return true
+	}
+	for _, name := range []string{"status", "environment", "level", "last"} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// executeItemAction runs a single-item update, or a bulk update when any of
+// the bulk selection flags are set.
+func executeItemAction(cmd *cobra.Command, cfg *cliConfig, args []string, body map[string]any, output string, bulk itemsBulkOptions) error {
+	if !bulk.enabled(cmd) {
+		return executeItemUpdate(cmd, cfg, args, body, output, 0, "")
+	}
+	if len(args) > 0 || cmd.Flags().Changed("id") || cmd.Flags().Changed("uuid") || cmd.Flags().Changed("counter") {
+		return fmt.Errorf("pass either an item identifier or bulk selection flags, not both")
+	}
+	if bulk.Stdin && (cmd.Flags().Changed("status") || cmd.Flags().Changed("environment") || cmd.Flags().Changed("level") || cmd.Flags().Changed("last")) {
+		return fmt.Errorf("use either --stdin or filter flags to select items, not both")
+	}
+	if bulk.Concurrency <= 0 {
+		return fmt.Errorf("--concurrency must be > 0")
+	}
+
+	var refs []identifierRef
+	var err error
+	if bulk.Stdin {
+		refs, err = readBulkItemRefs(cmd.InOrStdin())
+	} else {
+		refs, err = selectBulkItemRefs(cmd, cfg, bulk)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(refs) > 0 {
+		action := fmt.Sprintf("%s %d items", itemActionVerb(body), len(refs))
+		if bulk.Environment != "" {
+			action += " in " + bulk.Environment
+		}
+		if err := confirmAction(cmd, cfg, action); err != nil {
+			return err
+		}
+	}
+
+	report := runBulkItemUpdate(cmd, cfg, refs, body, bulk.Concurrency)
+	if err := writeBulkItemReport(cfg.Filter, report, output); err != nil {
+		return err
+	}
+	if report.Failed > 0 && report.DryRun {
+		return fmt.Errorf("%d of %d items could not be looked up", report.Failed, len(report.Results))
+	}
+	if report.Failed > 0 {
+		return fmt.Errorf("%d of %d item updates failed", report.Failed, len(report.Results))
+	}
+	return nil
+}
+
+func selectBulkItemRefs(cmd *cobra.Command, cfg *cliConfig, bulk itemsBulkOptions) ([]identifierRef, error) {
+	items, _, err := collectAndShapeItems(cmd, cfg, itemsListOptions{
+		Page:        1,
+		Pagination:  paginationOptions{All: true},
+		Status:      bulk.Status,
+		Environment: bulk.Environment,
+		Level:       bulk.Level,
+		Last:        bulk.Last,
+	})
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]identifierRef, 0, len(items))
+	for _, item := range items {
+		refs = append(refs, identifierRef{ID: item.ID})
+	}
+	return refs, nil
+}
+
+// readBulkItemRefs accepts whitespace-separated item IDs and #counters or
+// NDJSON records with an "id" field, skipping duplicates while keeping input
+// order.
+func readBulkItemRefs(r io.Reader) ([]identifierRef, error) {
+	var refs []identifierRef
+	seen := make(map[identifierRef]struct{})
+	add := func(ref identifierRef) {
+		if _, ok := seen[ref]; ok {
+			return
+		}
+		seen[ref] = struct{}{}
+		refs = append(refs, ref)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "{") {
+			id, err := bulkRecordID(line)
+			if err != nil {
+				return nil, fmt.Errorf("stdin line %d: %w", lineNo, err)
+			}
+			add(identifierRef{ID: id})
+			continue
+		}
+		for _, field := range strings.Fields(line) {
+			if counter, ok := strings.CutPrefix(field, "#"); ok {
+				n, err := strconv.ParseInt(counter, 10, 64)
+				if err != nil || n <= 0 {
+					return nil, fmt.Errorf("stdin line %d: invalid item counter %q", lineNo, field)
+				}
+				add(identifierRef{Counter: n})
+				continue
+			}
+			id, err := strconv.ParseInt(field, 10, 64)
+			if err != nil || id <= 0 {
+				return nil, fmt.Errorf("stdin line %d: invalid item id %q", lineNo, field)
+			}
+			add(identifierRef{ID: id})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read stdin: %w", err)
+	}
+	return refs, nil
+}
+
+func bulkRecordID(line string) (int64, error) {
+	var record map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(line), &record); err != nil {
+		return 0, fmt.Errorf("parse NDJSON record: %w", err)
+	}
+	for _, key := range []string{"id", "ID", "item_id"} {
+		raw, ok := record[key]
+		if !ok {
+			continue
+		}
+		var id int64
+		if err := json.Unmarshal(raw, &id); err != nil || id <= 0 {
+			return 0, fmt.Errorf("invalid item id %s", raw)
+		}
+		return id, nil
+	}
+	return 0, fmt.Errorf("NDJSON record has no id field")
+}
+
+func runBulkItemUpdate(cmd *cobra.Command, cfg *cliConfig, refs []identifierRef, body map[string]any, concurrency int) bulkItemReport {
+	client := newRollbarClient(cfg)
+	report := bulkItemReport{DryRun: cfg.DryRun, Body: body, Results: make([]bulkItemResult, len(refs))}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, ref := range refs {
+		report.Results[i] = bulkItemResult{ID: ref.ID, Counter: ref.Counter}
+		// Counters are still looked up on a dry run so the report has IDs.
+		if cfg.DryRun && ref.Counter == 0 {
+			report.Results[i].OK = true
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *bulkItemResult, ref identifierRef) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			// The item is fetched before every update anyway, so looking a
+			// counter up here costs no extra request.
+			before, err := getItemByRef(cmd.Context(), client, ref)
+			if err != nil {
+				result.Error = err.Error()
+				return
+			}
+			if ref.Counter > 0 {
+				if before.Item.ID <= 0 {
+					result.Error = fmt.Sprintf("could not resolve %s to a valid item id", ref)
+					return
+				}
+				result.ID = before.Item.ID
+			}
+			if cfg.DryRun {
+				result.OK = true
+				return
+			}
+			resp, err := updateItemAudited(cmd, cfg, client, result.ID, before, body, auditEntry{Source: auditSourceBulk})
+			if err != nil {
+				result.Error = err.Error()
+				return
+			}
+			result.OK = true
+			result.Raw = resp.Raw
+			if resp.Item.ID > 0 {
+				item := resp.Item
+				result.Item = &item
+			}
+		}(&report.Results[i], ref)
+	}
+	wg.Wait()
+
+	for _, result := range report.Results {
+		if result.OK {
+			report.Succeeded++
+		} else {
+			report.Failed++
+		}
+	}
+	return report
+}
+
+func writeBulkItemReport(filter outputFilter, report bulkItemReport, output string) error {
+	switch output {
+	case outputRawJSON:
+		return writeJSON(filter, report)
+	case outputJSON:
+		for i := range report.Results {
+			report.Results[i].Raw = nil
+		}
+		return writeJSON(filter, report)
+	}
+
+	if len(report.Results) == 0 {
+		return writeStdoutf("No items matched.\n")
+	}
+
+	bodyJSON, err := json.Marshal(report.Body)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, 0, len(report.Results))
+	for _, result := range report.Results {
+		switch {
+		case !result.OK:
+			rows = append(rows, []string{bulkResultRef(result), "failed", result.Error})
+		case report.DryRun:
+			rows = append(rows, []string{bulkResultRef(result), "pending", string(bodyJSON)})
+		default:
+			rows = append(rows, []string{bulkResultRef(result), "ok", bulkResultStatus(result)})
+		}
+	}
+	if err := renderRows([]string{"ID", "RESULT", "DETAIL"}, rows, true); err != nil {
+		return err
+	}
+
+	if report.DryRun && report.Failed > 0 {
+		return writeStdoutf("\nDry run: %d items would be updated (%d could not be looked up).\n", report.Succeeded, report.Failed)
+	}
+	if report.DryRun {
+		return writeStdoutf("\nDry run: %d items would be updated.\n", report.Succeeded)
+	}
+	return writeStdoutf("\nUpdated %d of %d items (%d failed).\n", report.Succeeded, len(report.Results), report.Failed)
+}
+
+// bulkResultRef names a result by item id, or by counter when the counter
+// never resolved to an id.
+func bulkResultRef(result bulkItemResult) string {
+	if result.ID == 0 && result.Counter > 0 {
+		return "#" + strconv.FormatInt(result.Counter, 10)
+	}
+	return strconv.FormatInt(result.ID, 10)
+}
+
+func bulkResultStatus(result bulkItemResult) string {
+	if result.Item == nil || result.Item.Status == "" {
+		return "-"
+	}
+	return result.Item.Status
+}
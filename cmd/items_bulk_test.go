@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func withStdin(t *testing.T, input string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "stdin")
+	if err := os.WriteFile(path, []byte(input), 0o600); err != nil {
+		t.Fatalf("write stdin: %v", err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open stdin: %v", err)
+	}
+	old := os.Stdin
+	os.Stdin = f
+	t.Cleanup(func() {
+		os.Stdin = old
+		_ = f.Close()
+	})
+}
+
+func TestReadBulkItemRefs(t *testing.T) {
+	input := "42 43\n\n{\"ID\":44,\"Title\":\"boom\"}\n{\"id\":42}\n45 #7 #7\n"
+	refs, err := readBulkItemRefs(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("readBulkItemRefs() error = %v", err)
+	}
+	want := []identifierRef{{ID: 42}, {ID: 43}, {ID: 44}, {ID: 45}, {Counter: 7}}
+	if !reflect.DeepEqual(refs, want) {
+		t.Fatalf("readBulkItemRefs() = %v, want %v", refs, want)
+	}
+
+	if _, err := readBulkItemRefs(strings.NewReader("42\nabc\n")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("expected line error, got %v", err)
+	}
+	if _, err := readBulkItemRefs(strings.NewReader("#0\n")); err == nil || !strings.Contains(err.Error(), "invalid item counter") {
+		t.Fatalf("expected counter error, got %v", err)
+	}
+	if _, err := readBulkItemRefs(strings.NewReader(`{"title":"no id"}`)); err == nil {
+		t.Fatal("expected missing id error")
+	}
+}
+
+func TestItemsResolveBulkFromStdinReportsFailures(t *testing.T) {
+	withStdin(t, "42\n43\n44\n")
+
+	var mu sync.Mutex
+	patched := map[string]map[string]any{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		patched[r.URL.Path] = body
+		mu.Unlock()
+		if r.URL.Path == "/api/1/item/43" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"err":1,"message":"not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"err":0,"result":{"id":1,"status":"resolved"}}`))
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"items", "resolve",
+		"--stdin",
+		"--resolved-in-version", "abc123",
+		"--yes",
+		"--json",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "1 of 3 item updates failed") {
+		t.Fatalf("expected partial failure error, got %v", err)
+	}
+	if len(patched) != 3 || patched["/api/1/item/44"]["resolved_in_version"] != "abc123" {
+		t.Fatalf("unexpected updates: %#v", patched)
+	}
+
+	var report bulkItemReport
+	if err := json.Unmarshal([]byte(out), &report); err != nil {
+		t.Fatalf("decode report: %v\n%s", err, out)
+	}
+	if report.Succeeded != 2 || report.Failed != 1 || report.Results[1].ID != 43 || report.Results[1].OK {
+		t.Fatalf("unexpected report: %#v", report)
+	}
+}
+
+func TestItemsMuteBulkFromFiltersDryRun(t *testing.T) {
+	var requests []string
+	var gotQuery string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.URL.Query().Get("page") == "1" {
+			gotQuery = r.URL.RawQuery
+			_, _ = w.Write([]byte(`{"err":0,"result":{"items":[{"id":42,"status":"active"},{"id":43,"status":"active"}]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"err":0,"result":{"items":[]}}`))
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"items", "mute",
+		"--status", "active",
+		"--environment", "production",
+		"--dry-run",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	for _, req := range requests {
+		if !strings.HasPrefix(req, "GET ") {
+			t.Fatalf("dry run sent a mutating request: %#v", requests)
+		}
+	}
+	if !strings.Contains(gotQuery, "environment=production") || !strings.Contains(gotQuery, "status=active") {
+		t.Fatalf("unexpected list query: %s", gotQuery)
+	}
+	if !strings.Contains(out, "42") || !strings.Contains(out, "43") || !strings.Contains(out, "2 items would be updated") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestItemsResolveBulkDryRunReportsLookupFailures(t *testing.T) {
+	withStdin(t, "42\n#9\n")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/1/item/42" {
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":42,"status":"active"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"err":1,"message":"not found"}`))
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"items", "resolve",
+		"--stdin",
+		"--dry-run",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "1 of 2 items could not be looked up") {
+		t.Fatalf("expected lookup failure error, got %v", err)
+	}
+	lines := strings.Split(out, "\n")
+	var pending, failed string
+	for _, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "42 "):
+			pending = line
+		case strings.HasPrefix(line, "#9 "):
+			failed = line
+		}
+	}
+	if !strings.Contains(pending, "pending") || !strings.Contains(failed, "failed") || strings.Contains(failed, "resolved") {
+		t.Fatalf("unexpected rows in output: %q", out)
+	}
+	if !strings.Contains(out, "Dry run: 1 items would be updated (1 could not be looked up).") {
+		t.Fatalf("unexpected summary: %q", out)
+	}
+}
+
+func TestItemsBulkRejectsIdentifierWithFilters(t *testing.T) {
+	_, err := runCLIWithCapturedStdout(t,
+		"items", "resolve", "42",
+		"--status", "active",
+		"--token", "tok",
+	)
+	if err == nil || !strings.Contains(err.Error(), "not both") {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+}
+
+func TestItemsResolveBulkCountersLookUpOnce(t *testing.T) {
+	withStdin(t, "#7\n#8\n#7\n")
+
+	var mu sync.Mutex
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/api/1/item_by_counter/7":
+			http.Redirect(w, r, "/api/1/item/42", http.StatusMovedPermanently)
+		case "/api/1/item_by_counter/8":
+			http.Redirect(w, r, "/api/1/item/43", http.StatusMovedPermanently)
+		case "/api/1/item/42":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":42,"counter":7,"status":"active"}}`))
+		case "/api/1/item/43":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":43,"counter":8,"status":"active"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"items", "resolve",
+		"--stdin",
+		"--yes",
+		"--json",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+
+	counts := map[string]int{}
+	for _, req := range requests {
+		counts[req]++
+	}
+	for _, req := range []string{"GET /api/1/item_by_counter/7", "GET /api/1/item/42", "PATCH /api/1/item/42", "GET /api/1/item_by_counter/8", "GET /api/1/item/43", "PATCH /api/1/item/43"} {
+		if counts[req] != 1 {
+			t.Fatalf("expected one %s, got requests %v", req, requests)
+		}
+	}
+	if len(requests) != 6 {
+		t.Fatalf("unexpected extra requests: %v", requests)
+	}
+
+	var report bulkItemReport
+	if err := json.Unmarshal([]byte(out), &report); err != nil {
+		t.Fatalf("decode report: %v\n%s", err, out)
+	}
+	if report.Succeeded != 2 || report.Results[0].ID != 42 || report.Results[0].Counter != 7 || report.Results[1].ID != 43 {
+		t.Fatalf("unexpected report: %#v", report)
+	}
+}
@@ -3,8 +3,10 @@ package cmd
 import (
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/davebarnwell/rollbar-cli/internal/ui"
@@ -83,22 +85,340 @@ func TestItemsCommandValidationErrors(t *testing.T) {
 }
 
 func TestPrepareWatchListOptionsForcesPlainTextDefaults(t *testing.T) {
-	opts := prepareWatchListOptions(itemsListOptions{})
+	opts := prepareWatchListOptions(outputFilter{}, itemsListOptions{})
 	if !reflect.DeepEqual(opts.Fields, ui.DefaultItemListFields()) {
 		t.Fatalf("prepareWatchListOptions() fields = %#v, want %#v", opts.Fields, ui.DefaultItemListFields())
 	}
 }
 
 func TestPrepareWatchListOptionsLeavesStructuredOutputUnchanged(t *testing.T) {
-	opts := prepareWatchListOptions(itemsListOptions{JSON: true})
+	opts := prepareWatchListOptions(outputFilter{}, itemsListOptions{JSON: true})
 	if len(opts.Fields) != 0 {
 		t.Fatalf("expected json watch options to keep empty fields, got %#v", opts.Fields)
 	}
 }
 
 func TestPrepareWatchListOptionsNormalizesEmptyFields(t *testing.T) {
-	opts := prepareWatchListOptions(itemsListOptions{Fields: []string{" ", ""}})
+	opts := prepareWatchListOptions(outputFilter{}, itemsListOptions{Fields: []string{" ", ""}})
 	if !reflect.DeepEqual(opts.Fields, ui.DefaultItemListFields()) {
 		t.Fatalf("prepareWatchListOptions() fields = %#v, want %#v", opts.Fields, ui.DefaultItemListFields())
 	}
 }
+
+func TestItemsListCommandTableFormats(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"err":0,"result":{"items":[{"id":42,"counter":10,"title":"boom, \"bang\" | crash","level":"error"}]}}`))
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		output string
+		want   string
+	}{
+		{output: "csv", want: "counter,title\n10,\"boom, \"\"bang\"\" | crash\"\n"},
+		{output: "tsv", want: "counter\ttitle\n10\tboom, \"bang\" | crash\n"},
+		{output: "yaml", want: "- counter: 10\n  title: \"boom, \\\"bang\\\" | crash\"\n"},
+		{output: "markdown", want: "| COUNTER | TITLE                 |\n| ------- | --------------------- |\n| 10      | boom, \"bang\" \\| crash |\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.output, func(t *testing.T) {
+			out, err := runCLIWithCapturedStdout(t,
+				"items", "list",
+				"--output", tt.output,
+				"--fields", "counter,title",
+				"--token", "tok",
+				"--base-url", ts.URL,
+			)
+			if err != nil {
+				t.Fatalf("unexpected command error: %v", err)
+			}
+			if out != tt.want {
+				t.Fatalf("unexpected %s output:\n%s\nwant\n%s", tt.output, out, tt.want)
+			}
+		})
+	}
+}
+
+func TestItemsListCommandPushesDownFilters(t *testing.T) {
+	var gotQuery url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		_, _ = w.Write([]byte(`{"err":0,"result":{"items":[]}}`))
+	}))
+	defer ts.Close()
+
+	_, err := runCLIWithCapturedStdout(t,
+		"items", "list",
+		"--unassigned",
+		"--framework", "django",
+		"--search", "TimeoutError",
+		"--json",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if gotQuery.Get("assigned_user") != "unassigned" || gotQuery.Get("framework") != "django" || gotQuery.Get("query") != "TimeoutError" {
+		t.Fatalf("filters were not sent to the API: %v", gotQuery)
+	}
+
+	_, err = runCLIWithCapturedStdout(t, "items", "list", "--unassigned", "--assigned-user", "ana", "--token", "tok", "--base-url", ts.URL)
+	if err == nil || !strings.Contains(err.Error(), "not both") {
+		t.Fatalf("expected conflicting assignee error, got %v", err)
+	}
+}
+
+func TestItemsListCommandExpandsPagesForClientSideFilters(t *testing.T) {
+	var requests atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"items":[{"id":1,"counter":1,"title":"TimeoutError: a","total_occurrences":500},{"id":2,"counter":2,"title":"KeyError","total_occurrences":900}]}}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"items":[{"id":3,"counter":3,"title":"TimeoutError: b","total_occurrences":50},{"id":4,"counter":4,"title":"TimeoutError: c","total_occurrences":101}]}}`))
+		case "3":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"items":[{"id":5,"counter":5,"title":"TimeoutError: d","total_occurrences":700}]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"err":0,"result":{"items":[]}}`))
+		}
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"items", "list",
+		"--title-match", "^TimeoutError",
+		"--min-occurrences", "100",
+		"--limit", "3",
+		"--sort", "counter_asc",
+		"--query", "[.items[].counter]",
+		"--json",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if strings.Join(strings.Fields(out), "") != "[1,4,5]" {
+		t.Fatalf("unexpected filtered items: %s", out)
+	}
+
+	requests.Store(0)
+	out, err = runCLIWithCapturedStdout(t,
+		"items", "list",
+		"--title-match", "^TimeoutError",
+		"--limit", "3",
+		"--max-pages", "1",
+		"--query", "[.items[].counter]",
+		"--json",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if strings.Join(strings.Fields(out), "") != "[1]" || requests.Load() != 1 {
+		t.Fatalf("expected an explicit --max-pages to stop expansion, got %s after %d requests", out, requests.Load())
+	}
+
+	if _, err := runCLIWithCapturedStdout(t, "items", "list", "--title-match", "(", "--token", "tok", "--base-url", ts.URL); err == nil || !strings.Contains(err.Error(), "--title-match") {
+		t.Fatalf("expected regex error, got %v", err)
+	}
+}
+
+func TestItemsListCommandTemplate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"err":0,"result":{"items":[{"id":42,"counter":10,"title":"boom","level":"error"},{"id":43,"counter":11,"title":"a much longer title","level":"warning"}]}}`))
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"items", "list",
+		"--output", "template",
+		"--template", "{{.counter}} {{.level | upper}} {{.title | truncate 8}}",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if out != "10 ERROR boom\n11 WARNING a much …\n" {
+		t.Fatalf("unexpected output: %q", out)
+	}
+
+	// The template sees the same snake_case fields whether or not a query
+	// reshapes the record first.
+	for _, query := range []string{"", "."} {
+		out, err = runCLIWithCapturedStdout(t,
+			"items", "list",
+			"--query", query,
+			"--template", "#{{.id}} {{.assigned_user | upper}}|",
+			"--token", "tok",
+			"--base-url", ts.URL,
+		)
+		if err != nil {
+			t.Fatalf("unexpected command error with query %q: %v", query, err)
+		}
+		if out != "#42 |\n#43 |\n" {
+			t.Fatalf("unexpected output with query %q: %q", query, out)
+		}
+	}
+
+	if _, err := runCLIWithCapturedStdout(t, "items", "list", "--output", "template", "--token", "tok", "--base-url", ts.URL); err == nil || !strings.Contains(err.Error(), "requires --template") {
+		t.Fatalf("expected missing template error, got %v", err)
+	}
+}
+
+func TestItemsListCommandQuery(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"err":0,"result":{"items":[{"id":42,"counter":10,"title":"boom","level":"error","total_occurrences":9},{"id":43,"counter":11,"title":"slow","level":"warning","total_occurrences":2}]}}`))
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"items", "list",
+		"--query", `.items[] | select(.total_occurrences > 5) | .title`,
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if out != "boom\n" {
+		t.Fatalf("expected raw string output, got %q", out)
+	}
+
+	out, err = runCLIWithCapturedStdout(t,
+		"items", "list",
+		"--ndjson",
+		"--query", `{counter, title}`,
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if out != "{\"counter\":10,\"title\":\"boom\"}\n{\"counter\":11,\"title\":\"slow\"}\n" {
+		t.Fatalf("unexpected ndjson output: %q", out)
+	}
+
+	out, err = runCLIWithCapturedStdout(t,
+		"items", "list",
+		"--raw-json",
+		"--query", `[.pages[].result.items[]] | length`,
+		"--template", "{{.}} items",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if out != "2 items\n" {
+		t.Fatalf("unexpected templated query output: %q", out)
+	}
+
+	if _, err := runCLIWithCapturedStdout(t, "items", "list", "--query", ".items[", "--token", "tok", "--base-url", ts.URL); err == nil || !strings.Contains(err.Error(), "invalid --query") {
+		t.Fatalf("expected query parse error, got %v", err)
+	}
+	if _, err := runCLIWithCapturedStdout(t, "items", "list", "--query", ".", "-o", "csv", "--token", "tok", "--base-url", ts.URL); err == nil || !strings.Contains(err.Error(), "cannot be combined") {
+		t.Fatalf("expected table format error, got %v", err)
+	}
+}
+
+func TestItemsGetCommandCSV(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"err":0,"result":{"id":42,"counter":10,"title":"boom","level":"error"}}`))
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"items", "get", "42",
+		"-o", "csv",
+		"--fields", "id,level",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if out != "id,level\n42,error\n" {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestItemsGetCommandAcceptsCounterAndURL(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/api/1/item_by_counter/10":
+			http.Redirect(w, r, "/api/1/item/42", http.StatusMovedPermanently)
+		case "/api/1/item/42":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":42,"counter":10,"title":"boom","level":"error"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	for _, arg := range []string{"#10", "https://rollbar.com/acme/api/items/10/"} {
+		paths = nil
+		out, err := runCLIWithCapturedStdout(t,
+			"items", "get", arg,
+			"--query", ".item.id",
+			"--json",
+			"--token", "tok",
+			"--base-url", ts.URL,
+		)
+		if err != nil {
+			t.Fatalf("items get %s: unexpected command error: %v", arg, err)
+		}
+		if strings.TrimSpace(out) != "42" || strings.Join(paths, ",") != "/api/1/item_by_counter/10,/api/1/item/42" {
+			t.Fatalf("items get %s: unexpected output %q via %v", arg, out, paths)
+		}
+	}
+}
+
+func TestItemsCounterFlag(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/api/1/item_by_counter/10":
+			http.Redirect(w, r, "/api/1/item/42", http.StatusMovedPermanently)
+		case "/api/1/item/42":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":42,"counter":10,"environment":"staging","status":"muted"}}`))
+		case "/api/1/item/42/instances":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"instances":[{"id":501}]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	if _, err := runCLIWithCapturedStdout(t, "items", "mute", "--counter", "10", "--json", "--token", "tok", "--base-url", ts.URL); err != nil {
+		t.Fatalf("unexpected mute error: %v", err)
+	}
+	if got := strings.Join(requests, ","); got != "GET /api/1/item_by_counter/10,GET /api/1/item/42,PATCH /api/1/item/42" {
+		t.Fatalf("unexpected mute requests: %s", got)
+	}
+
+	requests = nil
+	out, err := runCLIWithCapturedStdout(t, "occurrences", "list", "--item-counter", "10", "--query", "[.occurrences[].id]", "--json", "--token", "tok", "--base-url", ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected occurrences error: %v", err)
+	}
+	if strings.Join(strings.Fields(out), "") != "[501]" || requests[len(requests)-1] != "GET /api/1/item/42/instances" {
+		t.Fatalf("unexpected occurrences output %q via %v", out, requests)
+	}
+
+	_, err = runCLIWithCapturedStdout(t, "items", "get", "42", "--counter", "10", "--token", "tok")
+	if err == nil || !strings.Contains(err.Error(), "provide only one item identifier") {
+		t.Fatalf("expected conflicting identifier error, got %v", err)
+	}
+	_, err = runCLIWithCapturedStdout(t, "items", "get", "--counter", "0", "--token", "tok")
+	if err == nil || !strings.Contains(err.Error(), "invalid item counter") {
+		t.Fatalf("expected invalid counter error, got %v", err)
+	}
+}
@@ -2,10 +2,14 @@ package cmd
 
 import (
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
 )
 
 func newUpdateCmdForTest(opts *itemsUpdateOptions) *cobra.Command {
@@ -103,3 +107,80 @@ func TestParseItemTimeRange(t *testing.T) {
 		t.Fatalf("expected until to be empty")
 	}
 }
+
+func TestParseItemSort(t *testing.T) {
+	items := []rollbar.Item{
+		{ID: 1, Counter: 1, Level: "warning", TotalOccurrences: 50, LastOccurrenceTimestamp: 300},
+		{ID: 2, Counter: 2, Level: "error", TotalOccurrences: 10, LastOccurrenceTimestamp: 100},
+		{ID: 3, Counter: 3, Level: "critical", TotalOccurrences: 5, LastOccurrenceTimestamp: 200},
+		{ID: 4, Counter: 4, Level: "error", TotalOccurrences: 90, LastOccurrenceTimestamp: 400},
+	}
+
+	tests := []struct {
+		spec string
+		want []int64
+	}{
+		{spec: "", want: []int64{4, 1, 3, 2}},
+		{spec: "level,-total_occurrences", want: []int64{3, 4, 2, 1}},
+		{spec: "-level", want: []int64{1, 2, 4, 3}},
+		{spec: "total_occurrences_desc", want: []int64{4, 1, 2, 3}},
+		{spec: "counter_asc", want: []int64{1, 2, 3, 4}},
+		{spec: "last_occurrence_timestamp_desc", want: []int64{4, 1, 3, 2}},
+	}
+	for _, tt := range tests {
+		compare, err := parseItemSort(tt.spec)
+		if err != nil {
+			t.Fatalf("parseItemSort(%q) error = %v", tt.spec, err)
+		}
+		sorted := slices.Clone(items)
+		slices.SortStableFunc(sorted, compare)
+		got := make([]int64, 0, len(sorted))
+		for _, item := range sorted {
+			got = append(got, item.ID)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("sort %q = %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+
+	if _, err := parseItemSort("level,popularity"); err == nil || !strings.Contains(err.Error(), `"popularity"`) {
+		t.Fatalf("expected unknown key error, got %v", err)
+	}
+}
+
+func TestParseIdentifierArg(t *testing.T) {
+	tests := []struct {
+		arg  string
+		kind string
+		want identifierRef
+	}{
+		{arg: "42", kind: "item", want: identifierRef{ID: 42}},
+		{arg: "#7", kind: "item", want: identifierRef{Counter: 7}},
+		{arg: "abc-123", kind: "item", want: identifierRef{UUID: "abc-123"}},
+		{arg: "https://rollbar.com/acme/api/items/1234/", kind: "item", want: identifierRef{Counter: 1234}},
+		{arg: "https://rollbar.com/acme/api/items/1234/occurrences/567/", kind: "occurrence", want: identifierRef{ID: 567}},
+		{arg: "https://rollbar.com/item/uuid/?uuid=abc-123", kind: "item", want: identifierRef{UUID: "abc-123"}},
+		{arg: "https://rollbar.com/occurrence/uuid/?uuid=def-456", kind: "occurrence", want: identifierRef{UUID: "def-456"}},
+		{arg: "https://rollbar.com/acme/api/deploys/99/", kind: "deploy", want: identifierRef{ID: 99}},
+	}
+	for _, tt := range tests {
+		got, err := parseIdentifierArg(tt.arg, tt.kind)
+		if err != nil {
+			t.Fatalf("parseIdentifierArg(%q, %q): %v", tt.arg, tt.kind, err)
+		}
+		if got != tt.want {
+			t.Fatalf("parseIdentifierArg(%q, %q) = %+v, want %+v", tt.arg, tt.kind, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct{ arg, kind string }{
+		{arg: "#7", kind: "occurrence"},
+		{arg: "#0", kind: "item"},
+		{arg: "https://rollbar.com/acme/api/items/", kind: "item"},
+		{arg: "https://rollbar.com/acme/api/items/1234/", kind: "occurrence"},
+	} {
+		if _, err := parseIdentifierArg(tt.arg, tt.kind); err == nil {
+			t.Fatalf("expected error for %q as %s", tt.arg, tt.kind)
+		}
+	}
+}
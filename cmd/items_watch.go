@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+	"github.com/davebarnwell/rollbar-cli/internal/ui"
+)
+
+const defaultWatchMinDelta = 10
+
+// notifyDesktop is swapped out in tests so nothing pops up.
+var notifyDesktop = ui.Notify
+
+type itemsWatchOptions struct {
+	Interval time.Duration
+	Count    int
+	Changes  bool
+	MinDelta int64
+	Exec     string
+	Notify   bool
+}
+
+// changesOnly reports whether watch should diff polls instead of reprinting
+// the list. The event hooks only make sense on changes, so they imply it.
+func (o itemsWatchOptions) changesOnly(cmd *cobra.Command) bool {
+	return o.Changes || o.Exec != "" || o.Notify || cmd.Flags().Changed("min-delta")
+}
+
+func runItemsWatch(cmd *cobra.Command, cfg *cliConfig, listOpts itemsListOptions, opts itemsWatchOptions) error {
+	if opts.Interval <= 0 {
+		return fmt.Errorf("--interval must be > 0")
+	}
+	if opts.Count < 0 {
+		return fmt.Errorf("--count must be >= 0 (0 polls until interrupted)")
+	}
+	changes := opts.changesOnly(cmd)
+	if changes {
+		// The first poll only records the baseline, so a single poll would
+		// never report anything.
+		if !cmd.Flags().Changed("count") {
+			opts.Count = 0
+		} else if opts.Count == 1 {
+			return fmt.Errorf("--count 1 only records the baseline when watching for changes; use 0 or at least 2")
+		}
+	}
+
+	poll := func(i int) error {
+		if i > 0 {
+			if err := writeStdoutf("\n[%s]\n", time.Now().UTC().Format(time.RFC3339)); err != nil {
+				return err
+			}
+		}
+		return runItemsList(cmd, cfg, prepareWatchListOptions(cfg.Filter, listOpts))
+	}
+	if changes {
+		var err error
+		if poll, err = newWatchChangesPoller(cmd, cfg, listOpts, opts); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; opts.Count == 0 || i < opts.Count; i++ {
+		if err := poll(i); err != nil {
+			return err
+		}
+		if opts.Count == 0 || i+1 < opts.Count {
+			select {
+			case <-cmd.Context().Done():
+				return cmd.Context().Err()
+			case <-time.After(opts.Interval):
+			}
+		}
+	}
+	return nil
+}
+
+// newWatchChangesPoller returns a poll step that prints only what changed
+// since the previous poll and hands each change to the --exec and --notify
+// hooks. The first poll records the baseline.
+func newWatchChangesPoller(cmd *cobra.Command, cfg *cliConfig, listOpts itemsListOptions, opts itemsWatchOptions) (func(int) error, error) {
+	if opts.MinDelta <= 0 {
+		return nil, fmt.Errorf("--min-delta must be > 0")
+	}
+	output, err := resolveOutputModeWithAliases(cfg.Filter, listOpts.Output, listOpts.JSON, listOpts.RawJSON, listOpts.NDJSON, outputText, outputNDJSON)
+	if err != nil {
+		return nil, fmt.Errorf("%w (watching for changes prints text or ndjson events)", err)
+	}
+
+	watcher := rollbar.NewItemWatcher(opts.MinDelta, time.Now())
+	notify := opts.Notify
+	return func(i int) error {
+		items, _, err := collectAndShapeItems(cmd, cfg, listOpts)
+		if err != nil {
+			return err
+		}
+		events := watcher.Poll(items, time.Now())
+		if i == 0 && output == outputText {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Watching %d items for changes every %s.\n", len(items), opts.Interval)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		if output == outputNDJSON {
+			records := make([]any, 0, len(events))
+			for _, event := range events {
+				records = append(records, event)
+			}
+			err = writeNDJSON(cfg.Filter, records)
+		} else {
+			err = ui.RenderWatchEvents(events)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if opts.Exec != "" {
+				if err := runWatchExec(cmd.Context(), opts.Exec, event, cmd.ErrOrStderr()); err != nil {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "warning: --exec failed for %s event on item #%d: %v\n", event.Type, event.Item.Counter, err)
+				}
+			}
+			if notify {
+				title := fmt.Sprintf("Rollbar #%d %s", event.Item.Counter, event.Type)
+				if err := notifyDesktop(title, event.Item.Title+"\n"+ui.WatchEventSummary(event)); err != nil {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "warning: desktop notifications turned off: %v\n", err)
+					notify = false
+				}
+			}
+		}
+		return nil
+	}, nil
+}
+
+// runWatchExec runs command through the shell with the event as one line of
+// JSON on stdin. Its output goes to stderr so it cannot corrupt an NDJSON
+// event stream on stdout.
+func runWatchExec(ctx context.Context, command string, event rollbar.WatchEvent, stderr io.Writer) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	var c *exec.Cmd
+	if runtime.GOOS == "windows" {
+		c = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		c = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	c.Stdin = bytes.NewReader(append(payload, '\n'))
+	c.Stdout = stderr
+	c.Stderr = stderr
+	return c.Run()
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+func TestItemsWatchChangesEmitsNDJSONEventsAndRunsExec(t *testing.T) {
+	polls := []string{
+		`{"err":0,"result":{"items":[{"id":42,"counter":10,"title":"boom","level":"warning","status":"active","total_occurrences":5,"first_occurrence_timestamp":1600000000}]}}`,
+		`{"err":0,"result":{"items":[{"id":42,"counter":10,"title":"boom","level":"error","status":"active","total_occurrences":7,"first_occurrence_timestamp":1600000000},` +
+			`{"id":43,"counter":11,"title":"bang","level":"error","status":"active","total_occurrences":1}]}}`,
+		`{"err":0,"result":{"items":[{"id":42,"counter":10,"title":"boom","level":"error","status":"active","total_occurrences":9,"first_occurrence_timestamp":1600000000}]}}`,
+	}
+	var poll int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(polls[min(poll, len(polls)-1)]))
+		poll++
+	}))
+	defer ts.Close()
+
+	var notified []string
+	original := notifyDesktop
+	notifyDesktop = func(title, message string) error {
+		notified = append(notified, title)
+		return nil
+	}
+	t.Cleanup(func() { notifyDesktop = original })
+
+	hookOut := filepath.Join(t.TempDir(), "events.ndjson")
+	out, err := runCLIWithCapturedStdout(t,
+		"items", "watch",
+		"--interval", "1ms",
+		"--count", "3",
+		"--min-delta", "3",
+		"--exec", "cat >> "+hookOut,
+		"--notify",
+		"--ndjson",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+
+	var types []string
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		var event rollbar.WatchEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			t.Fatalf("invalid event %q: %v", line, err)
+		}
+		types = append(types, fmt.Sprintf("%s #%d", event.Type, event.Item.Counter))
+	}
+	// The occurrence count rose by 2 then 2 again, each below --min-delta.
+	if strings.Join(types, ",") != "escalated #10,new #11" {
+		t.Fatalf("unexpected events: %v", types)
+	}
+
+	hooked, err := os.ReadFile(hookOut)
+	if err != nil {
+		t.Fatalf("read exec output: %v", err)
+	}
+	if strings.TrimSpace(string(hooked)) != strings.TrimSpace(out) {
+		t.Fatalf("exec hook saw %q, want the emitted events %q", hooked, out)
+	}
+	if strings.Join(notified, ",") != "Rollbar #10 escalated,Rollbar #11 new" {
+		t.Fatalf("unexpected notifications: %v", notified)
+	}
+}
+
+func TestItemsWatchValidation(t *testing.T) {
+	_, err := runCLIWithCapturedStdout(t, "items", "watch", "--count", "-1", "--token", "tok")
+	if err == nil || !strings.Contains(err.Error(), "--count must be >= 0") {
+		t.Fatalf("expected count error, got %v", err)
+	}
+	_, err = runCLIWithCapturedStdout(t, "items", "watch", "--changes", "--json", "--token", "tok")
+	if err == nil || !strings.Contains(err.Error(), "text or ndjson") {
+		t.Fatalf("expected output mode error, got %v", err)
+	}
+	_, err = runCLIWithCapturedStdout(t, "items", "watch", "--changes", "--count", "1", "--token", "tok")
+	if err == nil || !strings.Contains(err.Error(), "only records the baseline") {
+		t.Fatalf("expected baseline-only count error, got %v", err)
+	}
+}
+
+func TestItemsWatchChangesPollsUntilStoppedByDefault(t *testing.T) {
+	var polls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls == 3 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"err":1,"message":"gone"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"err":0,"result":{"items":[]}}`))
+	}))
+	defer ts.Close()
+
+	_, err := runCLIWithCapturedStdout(t, "items", "watch", "--changes", "--interval", "1ms", "--ndjson", "--token", "tok", "--base-url", ts.URL)
+	if err == nil || polls != 3 {
+		t.Fatalf("expected watch to keep polling until the third poll failed, got %d polls and %v", polls, err)
+	}
+}
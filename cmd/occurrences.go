@@ -1,7 +1,12 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -13,6 +18,7 @@ type occurrencesListOptions struct {
 	ItemID          int64
 	ItemUUID        string
 	Page            int
+	Pagination      paginationOptions
 	Output          string
 	JSON            bool
 	RawJSON         bool
@@ -22,6 +28,11 @@ type occurrencesListOptions struct {
 	PayloadMode     string
 	PayloadSections []string
 	MaxPayloadBytes int
+	Limit           int
+	Since           string
+	Until           string
+	Last            time.Duration
+	Where           []string
 }
 
 type occurrencesGetOptions struct {
@@ -30,18 +41,49 @@ type occurrencesGetOptions struct {
 	Output          string
 	JSON            bool
 	RawJSON         bool
+	Fields          []string
 	PayloadMode     string
 	PayloadSections []string
 	MaxPayloadBytes int
+	Source          sourceOptions
+}
+
+type occurrencesBreakdownOptions struct {
+	ItemID     int64
+	ItemUUID   string
+	By         []string
+	Top        int
+	Page       int
+	Pagination paginationOptions
+	Since      string
+	Until      string
+	Last       time.Duration
+	Where      []string
+	Output     string
+	JSON       bool
+	NDJSON     bool
+	NoHeaders  bool
 }
 
 type occurrenceListJSONOutput struct {
-	Occurrences []rollbar.ItemInstance `json:"occurrences"`
+	SchemaVersion int                    `json:"schema_version"`
+	Occurrences   []rollbar.ItemInstance `json:"occurrences"`
+}
+
+type occurrenceGetJSONOutput struct {
+	Occurrence rollbar.ItemInstance `json:"occurrence"`
+}
+
+type occurrenceBreakdownJSONOutput struct {
+	SchemaVersion int                 `json:"schema_version"`
+	Occurrences   int64               `json:"occurrences"`
+	Breakdowns    []rollbar.Breakdown `json:"breakdowns"`
 }
 
 func newOccurrencesCmd(cfg *cliConfig) *cobra.Command {
 	var listOpts occurrencesListOptions
 	var getOpts occurrencesGetOptions
+	var breakdownOpts occurrencesBreakdownOptions
 
 	occurrencesCmd := &cobra.Command{
 		Use:     "occurrences",
@@ -58,40 +100,59 @@ func newOccurrencesCmd(cfg *cliConfig) *cobra.Command {
 				return err
 			}
 
-			output, err := resolveOutputModeWithAliases(listOpts.Output, listOpts.JSON, listOpts.RawJSON, listOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, listOpts.Output, listOpts.JSON, listOpts.RawJSON, listOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
 			if err != nil {
 				return err
 			}
 
-			itemID, itemUUID, err := resolveOccurrenceListItemIdentifier(cmd, args, listOpts)
+			itemRef, err := resolveOccurrenceListItemIdentifier(cmd, args, listOpts)
 			if err != nil {
 				return err
 			}
 
+			pagerOpts, err := listOpts.Pagination.pagerOptions(listOpts.Page)
+			if err != nil {
+				return err
+			}
+			if listOpts.Limit < 0 {
+				return fmt.Errorf("--limit must be >= 0")
+			}
+			filter, err := newOccurrenceFilter(listOpts.Since, listOpts.Until, listOpts.Last, listOpts.Where)
+			if err != nil {
+				return err
+			}
+			// --limit keeps fetching pages until it is met, unless the page count
+			// was pinned with --max-pages or --all.
+			if listOpts.Limit > 0 && !listOpts.Pagination.All && !cmd.Flags().Changed("max-pages") {
+				pagerOpts.MaxPages = 0
+			}
+
 			client := newRollbarClient(cfg)
-			identifier := itemUUID
-			if identifier == "" {
-				identifier = fmt.Sprintf("%d", itemID)
+			identifier, err := itemInstancesIdentifier(cmd.Context(), client, itemRef)
+			if err != nil {
+				return err
 			}
 
-			resp, err := client.ListItemInstances(cmd.Context(), identifier, listOpts.Page)
+			instances, rawPages, err := collectOccurrences(cmd.Context(), client.ItemInstancesPager(identifier, pagerOpts), filter, listOpts.Limit)
 			if err != nil {
 				return err
 			}
 
 			switch output {
 			case outputRawJSON:
-				return writeJSON(resp.Raw)
+				return writeJSON(cfg.Filter, rawPagesOutput(rawPages))
 			case outputJSON:
-				return writeJSON(occurrenceListJSONOutput{Occurrences: resp.Instances})
+				return writeJSON(cfg.Filter, occurrenceListJSONOutput{SchemaVersion: jsonSchemaVersion, Occurrences: instances})
 			case outputNDJSON:
-				records := make([]any, 0, len(resp.Instances))
-				for _, instance := range resp.Instances {
+				records := make([]any, 0, len(instances))
+				for _, instance := range instances {
 					records = append(records, instance)
 				}
-				return writeNDJSON(records)
+				return writeNDJSON(cfg.Filter, records)
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.OccurrencesTable(instances, normalizeFields(listOpts.Fields)), listOpts.NoHeaders)
 			default:
-				return ui.RenderOccurrencesWithOptions(resp.Instances, ui.OccurrenceRenderOptions{
+				return ui.RenderOccurrencesWithOptions(instances, ui.OccurrenceRenderOptions{
 					Fields:    normalizeFields(listOpts.Fields),
 					NoHeaders: listOpts.NoHeaders,
 					Payload: ui.PayloadRenderOptions{
@@ -113,7 +174,11 @@ func newOccurrencesCmd(cfg *cliConfig) *cobra.Command {
 				return err
 			}
 
-			output, err := resolveOutputModeWithAliases(getOpts.Output, getOpts.JSON, getOpts.RawJSON, false, outputText, outputJSON, outputRawJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, getOpts.Output, getOpts.JSON, getOpts.RawJSON, false, outputText, outputJSON, outputRawJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+			sourceOpts, err := getOpts.Source.renderOptions(cfg)
 			if err != nil {
 				return err
 			}
@@ -136,9 +201,11 @@ func newOccurrencesCmd(cfg *cliConfig) *cobra.Command {
 
 			switch output {
 			case outputRawJSON:
-				return writeJSON(resp.Raw)
+				return writeJSON(cfg.Filter, resp.Raw)
 			case outputJSON:
-				return writeJSON(map[string]rollbar.ItemInstance{"occurrence": resp.Occurrence})
+				return writeJSON(cfg.Filter, occurrenceGetJSONOutput{Occurrence: resp.Occurrence})
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.OccurrencesTable([]rollbar.ItemInstance{resp.Occurrence}, normalizeFields(getOpts.Fields)), false)
 			default:
 				return ui.RenderOccurrenceWithOptions(resp.Occurrence, ui.OccurrenceRenderOptions{
 					Payload: ui.PayloadRenderOptions{
@@ -146,34 +213,127 @@ func newOccurrencesCmd(cfg *cliConfig) *cobra.Command {
 						Sections:        normalizeFields(getOpts.PayloadSections),
 						MaxPayloadBytes: getOpts.MaxPayloadBytes,
 					},
+					Source: sourceOpts,
 				})
 			}
 		},
 	}
 
+	breakdownCmd := &cobra.Command{
+		Use:   "breakdown [item-id-or-uuid]",
+		Short: "Count an item's occurrences by payload values such as host, browser, or release",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, breakdownOpts.Output, breakdownOpts.JSON, false, breakdownOpts.NDJSON, outputText, outputJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+
+			itemRef, err := resolveOccurrencesItemIdentifier(cmd, args, breakdownOpts.ItemID, breakdownOpts.ItemUUID)
+			if err != nil {
+				return err
+			}
+			paths := normalizeFields(breakdownOpts.By)
+			if len(paths) == 0 {
+				return fmt.Errorf("--by is required, for example --by server.host,code_version")
+			}
+			if breakdownOpts.Top < 0 {
+				return fmt.Errorf("--top must be >= 0")
+			}
+			pagerOpts, err := breakdownOpts.Pagination.pagerOptions(breakdownOpts.Page)
+			if err != nil {
+				return err
+			}
+			filter, err := newOccurrenceFilter(breakdownOpts.Since, breakdownOpts.Until, breakdownOpts.Last, breakdownOpts.Where)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarClient(cfg)
+			identifier, err := itemInstancesIdentifier(cmd.Context(), client, itemRef)
+			if err != nil {
+				return err
+			}
+			instances, _, err := collectOccurrences(cmd.Context(), client.ItemInstancesPager(identifier, pagerOpts), filter, 0)
+			if err != nil {
+				return err
+			}
+
+			counter := rollbar.NewBreakdownCounter(paths)
+			for _, instance := range instances {
+				counter.Add(instance)
+			}
+			breakdowns := counter.Breakdowns(breakdownOpts.Top)
+
+			switch output {
+			case outputJSON:
+				return writeJSON(cfg.Filter, occurrenceBreakdownJSONOutput{SchemaVersion: jsonSchemaVersion, Occurrences: int64(len(instances)), Breakdowns: breakdowns})
+			case outputNDJSON:
+				records := make([]any, 0, len(breakdowns))
+				for _, breakdown := range breakdowns {
+					records = append(records, breakdown)
+				}
+				return writeNDJSON(cfg.Filter, records)
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.BreakdownsTable(breakdowns), breakdownOpts.NoHeaders)
+			default:
+				return ui.RenderBreakdowns(breakdowns, ui.ReportRenderOptions{NoHeaders: breakdownOpts.NoHeaders})
+			}
+		},
+	}
+
 	listCmd.Flags().Int64Var(&listOpts.ItemID, "item-id", 0, "Item ID")
 	listCmd.Flags().StringVar(&listOpts.ItemUUID, "item-uuid", "", "Item UUID")
-	listCmd.Flags().IntVar(&listOpts.Page, "page", 1, "Page number")
-	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson")
+	addItemCounterFlag(listCmd, "item-counter")
+	listCmd.Flags().IntVar(&listOpts.Page, "page", 1, "Starting page number")
+	addPaginationFlags(listCmd.Flags(), &listOpts.Pagination, 1)
+	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
 	listCmd.Flags().BoolVar(&listOpts.JSON, "json", false, "Shortcut for --output json")
 	listCmd.Flags().BoolVar(&listOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
 	listCmd.Flags().BoolVar(&listOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
-	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text output")
-	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text output")
+	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text, csv, tsv, yaml, and markdown output")
+	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
 	listCmd.Flags().StringVar(&listOpts.PayloadMode, "payload", "summary", "Payload mode for text output: none|summary|full")
 	listCmd.Flags().StringSliceVar(&listOpts.PayloadSections, "payload-section", nil, "Payload sections to include")
 	listCmd.Flags().IntVar(&listOpts.MaxPayloadBytes, "max-payload-bytes", 4096, "Maximum payload size to render in text output")
+	listCmd.Flags().IntVar(&listOpts.Limit, "limit", 0, "Maximum number of occurrences to return after filtering; fetches more pages as needed")
+	listCmd.Flags().StringVar(&listOpts.Since, "since", "", "Only include occurrences at or after this time")
+	listCmd.Flags().StringVar(&listOpts.Until, "until", "", "Only include occurrences at or before this time")
+	listCmd.Flags().DurationVar(&listOpts.Last, "last", 0, "Only include occurrences within this duration")
+	listCmd.Flags().StringArrayVar(&listOpts.Where, "where", nil, "Payload predicate such as request.url~/checkout/, person.id=42, or server.host!=web-3; repeat to require several")
 
 	getCmd.Flags().Int64Var(&getOpts.ID, "id", 0, "Occurrence ID")
 	getCmd.Flags().StringVar(&getOpts.UUID, "uuid", "", "Occurrence UUID")
-	getCmd.Flags().StringVarP(&getOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json")
+	getCmd.Flags().StringVarP(&getOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|csv|tsv|yaml|markdown|template")
 	getCmd.Flags().BoolVar(&getOpts.JSON, "json", false, "Shortcut for --output json")
 	getCmd.Flags().BoolVar(&getOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	getCmd.Flags().StringSliceVar(&getOpts.Fields, "fields", nil, "Fields to render in csv, tsv, yaml, and markdown output")
 	getCmd.Flags().StringVar(&getOpts.PayloadMode, "payload", "summary", "Payload mode for text output: none|summary|full")
 	getCmd.Flags().StringSliceVar(&getOpts.PayloadSections, "payload-section", nil, "Payload sections to include")
 	getCmd.Flags().IntVar(&getOpts.MaxPayloadBytes, "max-payload-bytes", 4096, "Maximum payload size to render in text output")
+	addSourceFlags(getCmd.Flags(), &getOpts.Source)
+
+	breakdownCmd.Flags().Int64Var(&breakdownOpts.ItemID, "item-id", 0, "Item ID")
+	breakdownCmd.Flags().StringVar(&breakdownOpts.ItemUUID, "item-uuid", "", "Item UUID")
+	addItemCounterFlag(breakdownCmd, "item-counter")
+	breakdownCmd.Flags().StringSliceVar(&breakdownOpts.By, "by", nil, "Comma-separated payload paths to group by, such as server.host,client.javascript.browser,code_version")
+	breakdownCmd.Flags().IntVar(&breakdownOpts.Top, "top", 10, "Values to show per path, busiest first; 0 shows every value")
+	breakdownCmd.Flags().IntVar(&breakdownOpts.Page, "page", 1, "Starting page number")
+	addPaginationFlags(breakdownCmd.Flags(), &breakdownOpts.Pagination, 10)
+	breakdownCmd.Flags().StringVar(&breakdownOpts.Since, "since", "", "Only count occurrences at or after this time")
+	breakdownCmd.Flags().StringVar(&breakdownOpts.Until, "until", "", "Only count occurrences at or before this time")
+	breakdownCmd.Flags().DurationVar(&breakdownOpts.Last, "last", 0, "Only count occurrences within this duration")
+	breakdownCmd.Flags().StringArrayVar(&breakdownOpts.Where, "where", nil, "Payload predicate such as request.url~/checkout/; repeat to require several")
+	breakdownCmd.Flags().StringVarP(&breakdownOpts.Output, "output", "o", outputText, "Output format: text|json|ndjson|csv|tsv|yaml|markdown|template")
+	breakdownCmd.Flags().BoolVar(&breakdownOpts.JSON, "json", false, "Shortcut for --output json")
+	breakdownCmd.Flags().BoolVar(&breakdownOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	breakdownCmd.Flags().BoolVar(&breakdownOpts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
 
-	occurrencesCmd.AddCommand(listCmd, getCmd)
+	occurrencesCmd.AddCommand(listCmd, getCmd, breakdownCmd, newOccurrencesDiffCmd(cfg))
 	return occurrencesCmd
 }
 
@@ -187,12 +347,191 @@ func resolveOccurrenceIdentifier(cmd *cobra.Command, args []string, opts occurre
 	return resolveIdentifierValue(arg, opts.ID, opts.UUID, idSet, uuidSet, "occurrence", "[id-or-uuid]", "--id", "--uuid")
 }
 
-func resolveOccurrenceListItemIdentifier(cmd *cobra.Command, args []string, opts occurrencesListOptions) (int64, string, error) {
-	idSet := cmd.Flags().Changed("item-id")
-	uuidSet := cmd.Flags().Changed("item-uuid")
-	arg := ""
-	if len(args) > 0 {
-		arg = args[0]
+func resolveOccurrenceListItemIdentifier(cmd *cobra.Command, args []string, opts occurrencesListOptions) (identifierRef, error) {
+	return resolveOccurrencesItemIdentifier(cmd, args, opts.ItemID, opts.ItemUUID)
+}
+
+func resolveOccurrencesItemIdentifier(cmd *cobra.Command, args []string, itemID int64, itemUUID string) (identifierRef, error) {
+	return resolveItemIdentifier(cmd, args, itemID, itemUUID, "item-")
+}
+
+// itemInstancesIdentifier is the path segment for an item's instances. The
+// endpoint takes an ID or UUID, so a counter is looked up first.
+func itemInstancesIdentifier(ctx context.Context, client *rollbar.Client, ref identifierRef) (string, error) {
+	if ref.Counter > 0 {
+		resp, err := client.GetItemByCounter(ctx, ref.Counter)
+		if err != nil {
+			return "", err
+		}
+		if resp.Item.ID <= 0 {
+			return "", fmt.Errorf("could not resolve %s to a valid item id", ref)
+		}
+		ref = identifierRef{ID: resp.Item.ID}
+	}
+	return ref.String(), nil
+}
+
+// collectOccurrences walks pages until limit occurrences pass the filter.
+// The API returns the newest occurrences first, so paging also stops once a
+// page reaches back past --since.
+func collectOccurrences(ctx context.Context, pager *rollbar.Pager[rollbar.ItemInstance], filter occurrenceFilter, limit int) ([]rollbar.ItemInstance, []map[string]any, error) {
+	instances := make([]rollbar.ItemInstance, 0)
+	rawPages := make([]map[string]any, 0)
+	for {
+		page, ok, err := pager.Next(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			break
+		}
+		rawPages = append(rawPages, page.Raw)
+		for _, instance := range page.Items {
+			if filter.keep(instance) {
+				instances = append(instances, instance)
+			}
+		}
+		if limit > 0 && len(instances) >= limit {
+			instances = instances[:limit]
+			break
+		}
+		if n := len(page.Items); n > 0 && !filter.since.IsZero() && page.Items[n-1].Timestamp < filter.since.Unix() {
+			break
+		}
+	}
+	return instances, rawPages, nil
+}
+
+type occurrenceFilter struct {
+	since time.Time
+	until time.Time
+	where []payloadPredicate
+}
+
+func newOccurrenceFilter(sinceValue, untilValue string, last time.Duration, where []string) (occurrenceFilter, error) {
+	since, until, err := parseTimeRange(sinceValue, untilValue, last)
+	if err != nil {
+		return occurrenceFilter{}, err
+	}
+	filter := occurrenceFilter{since: since, until: until}
+	for _, expr := range where {
+		predicate, err := parsePayloadPredicate(expr)
+		if err != nil {
+			return occurrenceFilter{}, err
+		}
+		filter.where = append(filter.where, predicate)
+	}
+	return filter, nil
+}
+
+func (f occurrenceFilter) keep(instance rollbar.ItemInstance) bool {
+	if !f.since.IsZero() && instance.Timestamp < f.since.Unix() {
+		return false
+	}
+	if !f.until.IsZero() && instance.Timestamp > f.until.Unix() {
+		return false
+	}
+	for _, predicate := range f.where {
+		if !predicate.match(instance.Payload) {
+			return false
+		}
+	}
+	return true
+}
+
+// payloadPredicate is one --where expression: a payload path, an operator,
+// and a value. = and != compare text, ~ and !~ match a regular expression
+// (optionally written /re/ or /re/i), and < <= > >= compare numbers.
+type payloadPredicate struct {
+	path   string
+	op     string
+	value  string
+	number float64
+	re     *regexp.Regexp
+}
+
+var payloadPredicateOps = []string{"!=", "!~", ">=", "<=", "=", "~", ">", "<"}
+
+func parsePayloadPredicate(expr string) (payloadPredicate, error) {
+	idx := strings.IndexAny(expr, "=!~<>")
+	if idx <= 0 {
+		return payloadPredicate{}, fmt.Errorf("invalid --where %q: expected <path><op><value> with op one of %s", expr, strings.Join(payloadPredicateOps, " "))
+	}
+	var op string
+	for _, candidate := range payloadPredicateOps {
+		if strings.HasPrefix(expr[idx:], candidate) {
+			op = candidate
+			break
+		}
+	}
+	if op == "" {
+		return payloadPredicate{}, fmt.Errorf("invalid --where %q: unknown operator", expr)
+	}
+
+	predicate := payloadPredicate{
+		path:  strings.TrimSpace(expr[:idx]),
+		op:    op,
+		value: strings.TrimSpace(expr[idx+len(op):]),
+	}
+	switch op {
+	case "~", "!~":
+		pattern := predicate.value
+		if len(pattern) >= 2 && pattern[0] == '/' {
+			if end := strings.LastIndex(pattern, "/"); end > 0 {
+				flags := pattern[end+1:]
+				pattern = pattern[1:end]
+				if flags == "i" {
+					pattern = "(?i)" + pattern
+				} else if flags != "" {
+					return payloadPredicate{}, fmt.Errorf("invalid --where %q: unsupported regex flags %q", expr, flags)
+				}
+			}
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return payloadPredicate{}, fmt.Errorf("invalid --where %q: %w", expr, err)
+		}
+		predicate.re = re
+	case "<", "<=", ">", ">=":
+		number, err := strconv.ParseFloat(predicate.value, 64)
+		if err != nil {
+			return payloadPredicate{}, fmt.Errorf("invalid --where %q: %s needs a number", expr, op)
+		}
+		predicate.number = number
+	}
+	return predicate, nil
+}
+
+// match treats a missing path as matching only the negated operators.
+func (p payloadPredicate) match(payload map[string]any) bool {
+	value, ok := rollbar.PayloadValue(payload, p.path)
+	if !ok {
+		return p.op == "!=" || p.op == "!~"
+	}
+	text := rollbar.PayloadText(value)
+	switch p.op {
+	case "=":
+		return text == p.value
+	case "!=":
+		return text != p.value
+	case "~":
+		return p.re.MatchString(text)
+	case "!~":
+		return !p.re.MatchString(text)
+	}
+
+	number, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return false
+	}
+	switch p.op {
+	case "<":
+		return number < p.number
+	case "<=":
+		return number <= p.number
+	case ">":
+		return number > p.number
+	default:
+		return number >= p.number
 	}
-	return resolveIdentifierValue(arg, opts.ItemID, opts.ItemUUID, idSet, uuidSet, "item", "[item-id-or-uuid]", "--item-id", "--item-uuid")
 }
@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -36,6 +39,62 @@ func TestOccurrencesListCommandJSON(t *testing.T) {
 	}
 }
 
+func TestOccurrencesListCommandFiltersAcrossPages(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "1":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"instances":[` +
+				`{"id":1,"timestamp":1700000900,"request":{"url":"https://shop.test/checkout/pay"},"server":{"host":"web-3"}},` +
+				`{"id":2,"timestamp":1700000800,"request":{"url":"https://shop.test/cart"},"server":{"host":"web-1"}}]}}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"instances":[` +
+				`{"id":3,"timestamp":1700000700,"data":{"request":{"url":"https://shop.test/Checkout"},"person":{"id":42}}},` +
+				`{"id":4,"timestamp":1700000100,"request":{"url":"https://shop.test/checkout"}}]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"err":0,"result":{"instances":[]}}`))
+		}
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"occurrences", "list", "42",
+		"--where", "request.url~/checkout/i",
+		"--where", "server.host!=web-3",
+		"--since", "1700000500",
+		"--all",
+		"--query", "[.occurrences[].id]",
+		"--json",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if strings.Join(strings.Fields(out), "") != "[3]" {
+		t.Fatalf("unexpected occurrences: %s", out)
+	}
+
+	out, err = runCLIWithCapturedStdout(t,
+		"occurrences", "list", "42",
+		"--where", "person.id=42",
+		"--limit", "1",
+		"--query", "[.occurrences[].id]",
+		"--json",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if strings.Join(strings.Fields(out), "") != "[3]" {
+		t.Fatalf("expected --limit to page until a match, got %s", out)
+	}
+
+	if _, err := runCLIWithCapturedStdout(t, "occurrences", "list", "42", "--where", "person.id", "--token", "tok", "--base-url", ts.URL); err == nil || !strings.Contains(err.Error(), "invalid --where") {
+		t.Fatalf("expected predicate error, got %v", err)
+	}
+}
+
 func TestOccurrencesGetCommandTextByIDUsingAlias(t *testing.T) {
 	var gotPath string
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -69,3 +128,139 @@ func TestOccurrencesListCommandValidationErrors(t *testing.T) {
 		t.Fatalf("expected missing identifier error, got %v", err)
 	}
 }
+
+func TestOccurrencesBreakdownCommandJSON(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/item/42/instances" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		switch r.URL.Query().Get("page") {
+		case "1":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"instances":[` +
+				`{"id":1,"timestamp":1700000900,"data":{"server":{"host":"web-1"},"code_version":"abc"}},` +
+				`{"id":2,"timestamp":1700000800,"data":{"server":{"host":"web-2"},"code_version":"abc"}}]}}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"instances":[` +
+				`{"id":3,"timestamp":1700000700,"data":{"server":{"host":"web-1"},"code_version":"def"}}]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"err":0,"result":{"instances":[]}}`))
+		}
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"occurrences", "breakdown", "42",
+		"--by", "server.host,code_version",
+		"--top", "1",
+		"--all",
+		"--json",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+
+	var got occurrenceBreakdownJSONOutput
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid json: %v\n%s", err, out)
+	}
+	if got.Occurrences != 3 || len(got.Breakdowns) != 2 {
+		t.Fatalf("unexpected breakdown: %+v", got)
+	}
+	host := got.Breakdowns[0]
+	if host.Path != "server.host" || len(host.Values) != 1 || host.Values[0].Value != "web-1" || host.Values[0].Count != 2 || host.Other != 1 {
+		t.Fatalf("unexpected server.host breakdown: %+v", host)
+	}
+	if version := got.Breakdowns[1]; version.Values[0].Value != "abc" || version.Values[0].Percent != 66.67 {
+		t.Fatalf("unexpected code_version breakdown: %+v", version)
+	}
+}
+
+func TestOccurrencesBreakdownCommandRequiresBy(t *testing.T) {
+	_, err := runCLIWithCapturedStdout(t, "occurrences", "breakdown", "42", "--token", "tok")
+	if err == nil || !strings.Contains(err.Error(), "--by is required") {
+		t.Fatalf("expected --by error, got %v", err)
+	}
+}
+
+func TestOccurrencesDiffCommandJSON(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/instance/501":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":501,"data":{"request":{"url":"/a","headers":{"Cookie":"s=1"}},` +
+				`"body":{"trace":{"frames":[{"filename":"app.py","lineno":10,"method":"handler"}]}}}}}`))
+		case "/api/1/instance/502":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":502,"data":{"request":{"url":"/b","headers":{"Cookie":"s=2"}},` +
+				`"body":{"trace":{"frames":[{"filename":"app.py","lineno":11,"method":"handler"}]}}}}}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"occurrences", "diff", "501", "502",
+		"--payload-section", "data",
+		"--json",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+
+	var got occurrenceDiffJSONOutput
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid json: %v\n%s", err, out)
+	}
+	if got.Before.ID != 501 || got.After.ID != 502 {
+		t.Fatalf("unexpected sides: %+v %+v", got.Before, got.After)
+	}
+	var paths []string
+	for _, change := range got.Payload {
+		paths = append(paths, change.Path+" "+change.Change)
+	}
+	// The cookie is redacted on both sides, so it does not show up as a change.
+	if strings.Join(paths, ",") != "data.body.trace.frames.0.lineno changed,data.request.url changed" {
+		t.Fatalf("unexpected payload changes: %v", paths)
+	}
+	if len(got.StackFrames) != 2 || got.StackFrames[0].Change != "removed" || got.StackFrames[1].Change != "added" {
+		t.Fatalf("unexpected stack frame diff: %+v", got.StackFrames)
+	}
+}
+
+func TestOccurrencesGetCommandSourceContext(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "src"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "src", "cart.js"), []byte("const a = 1;\nthrow new Error('boom');\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"default_profile":"web","profiles":{"web":{"path_rewrites":["webpack:///./="]}}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"err":0,"result":{"id":501,"data":{"body":{"trace":{"frames":[` +
+			`{"filename":"webpack:///./src/cart.js","lineno":2,"method":"checkout"}]}}}}}`))
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"occurrences", "get", "501",
+		"--source-root", root,
+		"--context", "1",
+		"--config", configPath,
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if !strings.Contains(out, "[app] webpack:///./src/cart.js:2 (checkout)\n        1 | const a = 1;\n      > 2 | throw new Error('boom');") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
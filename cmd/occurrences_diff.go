@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+	"github.com/davebarnwell/rollbar-cli/internal/ui"
+)
+
+type occurrencesDiffOptions struct {
+	Output          string
+	JSON            bool
+	PayloadMode     string
+	PayloadSections []string
+	Redact          bool
+	Color           string
+}
+
+type occurrenceDiffSide struct {
+	ID          int64  `json:"id"`
+	UUID        string `json:"uuid"`
+	Level       string `json:"level"`
+	Environment string `json:"environment"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+type occurrenceDiffJSONOutput struct {
+	SchemaVersion int                     `json:"schema_version"`
+	Before        occurrenceDiffSide      `json:"before"`
+	After         occurrenceDiffSide      `json:"after"`
+	Payload       []rollbar.PayloadChange `json:"payload"`
+	StackFrames   []rollbar.FrameChange   `json:"stack_frames"`
+}
+
+func newOccurrencesDiffCmd(cfg *cliConfig) *cobra.Command {
+	var opts occurrencesDiffOptions
+
+	cmd := &cobra.Command{
+		Use:   "diff <id-or-uuid-a> <id-or-uuid-b>",
+		Short: "Compare the payloads and stack frames of two occurrences",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, opts.Output, opts.JSON, false, false, outputText, outputJSON)
+			if err != nil {
+				return err
+			}
+			color, err := resolveColor(opts.Color)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarClient(cfg)
+			before, err := fetchOccurrence(cmd.Context(), client, args[0])
+			if err != nil {
+				return err
+			}
+			after, err := fetchOccurrence(cmd.Context(), client, args[1])
+			if err != nil {
+				return err
+			}
+
+			shape := payloadOptions{Mode: opts.PayloadMode, Sections: normalizeFields(opts.PayloadSections), RedactKeys: opts.Redact}
+			payload := rollbar.DiffPayloads(shapePayload(before.Payload, shape), shapePayload(after.Payload, shape))
+			frames := rollbar.DiffStackFrames(before.StackFrames, after.StackFrames)
+
+			if output == outputJSON {
+				return writeJSON(cfg.Filter, occurrenceDiffJSONOutput{
+					SchemaVersion: jsonSchemaVersion,
+					Before:        newOccurrenceDiffSide(before),
+					After:         newOccurrenceDiffSide(after),
+					Payload:       payload,
+					StackFrames:   frames,
+				})
+			}
+			return ui.RenderOccurrenceDiff(before, after, payload, frames, ui.OccurrenceDiffRenderOptions{Color: color})
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", outputText, "Output format: text|json|template")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Shortcut for --output json")
+	cmd.Flags().StringVar(&opts.PayloadMode, "payload", "full", "Payload mode to compare: none|summary|full")
+	cmd.Flags().StringSliceVar(&opts.PayloadSections, "payload-section", nil, "Payload sections to compare")
+	cmd.Flags().BoolVar(&opts.Redact, "redact", true, "Redact tokens, passwords, cookies, and similar keys before comparing")
+	cmd.Flags().StringVar(&opts.Color, "color", "auto", "Color text output: auto|always|never")
+	return cmd
+}
+
+func fetchOccurrence(ctx context.Context, client *rollbar.Client, arg string) (rollbar.ItemInstance, error) {
+	id, uuid, err := resolveIdentifierValue(arg, 0, "", false, false, "occurrence", "<id-or-uuid>", "--id", "--uuid")
+	if err != nil {
+		return rollbar.ItemInstance{}, err
+	}
+	var resp *rollbar.GetOccurrenceResponse
+	if uuid != "" {
+		resp, err = client.GetOccurrenceByUUID(ctx, uuid)
+	} else {
+		resp, err = client.GetOccurrenceByID(ctx, id)
+	}
+	if err != nil {
+		return rollbar.ItemInstance{}, err
+	}
+	return resp.Occurrence, nil
+}
+
+func newOccurrenceDiffSide(instance rollbar.ItemInstance) occurrenceDiffSide {
+	return occurrenceDiffSide{
+		ID:          instance.ID,
+		UUID:        instance.UUID,
+		Level:       instance.Level,
+		Environment: instance.Environment,
+		Timestamp:   instance.Timestamp,
+	}
+}
+
+// resolveColor turns --color into a yes or no. auto colors only a terminal
+// and honours NO_COLOR.
+func resolveColor(mode string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "", "auto":
+		return os.Getenv("NO_COLOR") == "" && term.IsTerminal(int(os.Stdout.Fd())), nil
+	default:
+		return false, fmt.Errorf("invalid --color %q: use auto, always, or never", mode)
+	}
+}
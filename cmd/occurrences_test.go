@@ -29,11 +29,47 @@ func TestResolveOccurrenceListItemIdentifier(t *testing.T) {
 	cmd.Flags().Int64("item-id", 0, "")
 	cmd.Flags().String("item-uuid", "", "")
 
-	gotID, gotUUID, err := resolveOccurrenceListItemIdentifier(cmd, []string{"item-uuid"}, occurrencesListOptions{})
+	got, err := resolveOccurrenceListItemIdentifier(cmd, []string{"item-uuid"}, occurrencesListOptions{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if gotID != 0 || gotUUID != "item-uuid" {
-		t.Fatalf("unexpected result: (%d, %q)", gotID, gotUUID)
+	if got != (identifierRef{UUID: "item-uuid"}) {
+		t.Fatalf("unexpected result: %+v", got)
+	}
+}
+
+func TestPayloadPredicate(t *testing.T) {
+	payload := map[string]any{
+		"request": map[string]any{"url": "https://shop.test/checkout"},
+		"data":    map[string]any{"person": map[string]any{"id": float64(42)}},
+		"server":  map[string]any{"host": "web-3", "cpu": float64(0.75)},
+	}
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{expr: "request.url~/checkout/", want: true},
+		{expr: "request.url!~checkout", want: false},
+		{expr: "person.id=42", want: true},
+		{expr: "person.id>=43", want: false},
+		{expr: "server.cpu<0.8", want: true},
+		{expr: "server.host = web-3", want: true},
+		{expr: "server.region=eu", want: false},
+		{expr: "server.region!=eu", want: true},
+	}
+	for _, tt := range tests {
+		predicate, err := parsePayloadPredicate(tt.expr)
+		if err != nil {
+			t.Fatalf("parsePayloadPredicate(%q) error = %v", tt.expr, err)
+		}
+		if got := predicate.match(payload); got != tt.want {
+			t.Errorf("%q matched = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+
+	for _, expr := range []string{"=42", "person.id>many", "request.url~/(/", "request.url~/x/g"} {
+		if _, err := parsePayloadPredicate(expr); err == nil {
+			t.Errorf("expected %q to be rejected", expr)
+		}
 	}
 }
@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+	"github.com/davebarnwell/rollbar-cli/internal/ui"
+)
+
+const rollbarWebURL = "https://rollbar.com"
+
+// openURL is swapped out in tests so nothing launches a browser.
+var openURL = ui.OpenURL
+
+type openOptions struct {
+	ProjectSlug string
+	Print       bool
+}
+
+func newOpenCmd(cfg *cliConfig) *cobra.Command {
+	var opts openOptions
+
+	openCmd := &cobra.Command{
+		Use:   "open",
+		Short: "Open an item, occurrence, or deploy in the Rollbar web app",
+	}
+	openCmd.PersistentFlags().StringVar(&opts.ProjectSlug, "project-slug", "", "Rollbar account/project path used in web links, e.g. acme/api (default from profile project_slug)")
+	openCmd.PersistentFlags().BoolVar(&opts.Print, "print", false, "Print the URL instead of opening a browser")
+
+	itemCmd := &cobra.Command{
+		Use:   "item <id-or-uuid>",
+		Short: "Open an item",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runOpen(cmd, cfg, opts, args[0], "item", itemWebURL)
+		},
+	}
+	occurrenceCmd := &cobra.Command{
+		Use:     "occurrence <id-or-uuid>",
+		Aliases: []string{"occurence"},
+		Short:   "Open an occurrence",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runOpen(cmd, cfg, opts, args[0], "occurrence", occurrenceWebURL)
+		},
+	}
+	deployCmd := &cobra.Command{
+		Use:   "deploy <id>",
+		Short: "Open a deploy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runOpen(cmd, cfg, opts, args[0], "deploy", deployWebURL)
+		},
+	}
+
+	openCmd.AddCommand(itemCmd, occurrenceCmd, deployCmd)
+	return openCmd
+}
+
+type webURLBuilder func(ctx context.Context, cfg *cliConfig, slug string, arg string) (string, error)
+
+func runOpen(cmd *cobra.Command, cfg *cliConfig, opts openOptions, arg string, kind string, build webURLBuilder) error {
+	arg = strings.TrimSpace(arg)
+	target := arg
+	if isWebURL(arg) {
+		if err := checkRollbarWebURL(arg, kind); err != nil {
+			return err
+		}
+	} else {
+		slug := strings.Trim(strings.TrimSpace(opts.ProjectSlug), "/")
+		if slug == "" {
+			slug = cfg.ProjectSlug
+		}
+		var err error
+		if target, err = build(cmd.Context(), cfg, slug, arg); err != nil {
+			return err
+		}
+	}
+
+	if opts.Print {
+		_, err := fmt.Fprintln(os.Stdout, target)
+		return err
+	}
+	if err := openURL(target); err != nil {
+		return fmt.Errorf("open %s: %w", target, err)
+	}
+	return nil
+}
+
+// checkRollbarWebURL accepts a pasted link only if it is a Rollbar page for
+// the kind being opened, so open never launches an arbitrary site.
+func checkRollbarWebURL(raw string, kind string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid Rollbar URL %q: %w", raw, err)
+	}
+	if u.Scheme != "https" || !strings.EqualFold(u.Host, "rollbar.com") {
+		return fmt.Errorf("refusing to open %q: only https://rollbar.com links can be opened", raw)
+	}
+	_, err = parseIdentifierArg(raw, kind)
+	return err
+}
+
+func itemWebURL(ctx context.Context, cfg *cliConfig, slug string, arg string) (string, error) {
+	ref, err := parseIdentifierArg(arg, "item")
+	if err != nil {
+		return "", err
+	}
+	// Rollbar can find an item from its UUID alone; every other link needs
+	// the project path and the item counter.
+	if ref.UUID != "" && slug == "" {
+		return rollbarWebURL + "/item/uuid/?uuid=" + url.QueryEscape(ref.UUID), nil
+	}
+	if err := requireProjectSlug(slug); err != nil {
+		return "", err
+	}
+	if ref.Counter <= 0 {
+		if err := requireToken(cfg); err != nil {
+			return "", err
+		}
+		resp, err := getItemByRef(ctx, newRollbarClient(cfg), ref)
+		if err != nil {
+			return "", err
+		}
+		ref.Counter = resp.Item.Counter
+	}
+	return itemCounterWebURL(slug, ref.Counter)
+}
+
+func itemCounterWebURL(slug string, counter int64) (string, error) {
+	if err := requireProjectSlug(slug); err != nil {
+		return "", err
+	}
+	if counter <= 0 {
+		return "", fmt.Errorf("item has no counter to link to")
+	}
+	return fmt.Sprintf("%s/%s/items/%d/", rollbarWebURL, slug, counter), nil
+}
+
+func occurrenceWebURL(ctx context.Context, cfg *cliConfig, _ string, arg string) (string, error) {
+	ref, err := parseIdentifierArg(arg, "occurrence")
+	if err != nil {
+		return "", err
+	}
+	if ref.UUID == "" {
+		if err := requireToken(cfg); err != nil {
+			return "", err
+		}
+		resp, err := newRollbarClient(cfg).GetOccurrenceByID(ctx, ref.ID)
+		if err != nil {
+			return "", err
+		}
+		if ref.UUID = resp.Occurrence.UUID; ref.UUID == "" {
+			return "", fmt.Errorf("occurrence %d has no uuid to link to", ref.ID)
+		}
+	}
+	return rollbarWebURL + "/occurrence/uuid/?uuid=" + url.QueryEscape(ref.UUID), nil
+}
+
+func deployWebURL(_ context.Context, _ *cliConfig, slug string, arg string) (string, error) {
+	id, err := parsePositiveDeployID(arg)
+	if err != nil {
+		return "", err
+	}
+	if err := requireProjectSlug(slug); err != nil {
+		return "", err
+	}
+	return rollbarWebURL + "/" + slug + "/deploys/" + strconv.FormatInt(id, 10) + "/", nil
+}
+
+func requireProjectSlug(slug string) error {
+	if slug == "" {
+		return fmt.Errorf("missing project slug: pass --project-slug or set project_slug in the profile")
+	}
+	return nil
+}
+
+// openItemInBrowser backs the TUI's open key.
+func openItemInBrowser(slug string, item rollbar.Item) (string, error) {
+	target, err := itemCounterWebURL(slug, item.Counter)
+	if err != nil {
+		return "", err
+	}
+	return target, openURL(target)
+}
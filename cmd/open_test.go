@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestOpenCommandPrintsWebURLs(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/item/42":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":42,"counter":10}}`))
+		case "/api/1/instance/501":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":501,"uuid":"inst-1"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"item", "42"}, want: "https://rollbar.com/acme/api/items/10/"},
+		{args: []string{"item", "#7"}, want: "https://rollbar.com/acme/api/items/7/"},
+		{args: []string{"occurrence", "501"}, want: "https://rollbar.com/occurrence/uuid/?uuid=inst-1"},
+		{args: []string{"deploy", "99"}, want: "https://rollbar.com/acme/api/deploys/99/"},
+		{args: []string{"item", "https://rollbar.com/acme/web/items/3/"}, want: "https://rollbar.com/acme/web/items/3/"},
+	}
+	for _, tt := range tests {
+		args := append([]string{"open"}, tt.args...)
+		args = append(args, "--print", "--project-slug", "acme/api", "--token", "tok", "--base-url", ts.URL)
+		out, err := runCLIWithCapturedStdout(t, args...)
+		if err != nil {
+			t.Fatalf("open %v: unexpected command error: %v", tt.args, err)
+		}
+		if strings.TrimSpace(out) != tt.want {
+			t.Fatalf("open %v = %q, want %q", tt.args, strings.TrimSpace(out), tt.want)
+		}
+	}
+}
+
+func TestOpenCommandLaunchesBrowser(t *testing.T) {
+	var opened string
+	original := openURL
+	openURL = func(target string) error {
+		opened = target
+		return nil
+	}
+	t.Cleanup(func() { openURL = original })
+
+	if _, err := runCLIWithCapturedStdout(t, "open", "item", "uuid-1"); err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if opened != "https://rollbar.com/item/uuid/?uuid=uuid-1" {
+		t.Fatalf("unexpected URL opened: %q", opened)
+	}
+
+	_, err := runCLIWithCapturedStdout(t, "open", "deploy", "99")
+	if err == nil || !strings.Contains(err.Error(), "missing project slug") {
+		t.Fatalf("expected missing project slug error, got %v", err)
+	}
+
+	for _, args := range [][]string{
+		{"deploy", "https://anything.example/"},
+		{"item", "https://rollbar.com.evil.example/acme/api/items/3/"},
+		{"item", "http://rollbar.com/acme/api/items/3/"},
+		{"occurrence", "https://rollbar.com/acme/api/items/3/"},
+		{"deploy", "https://rollbar.com/acme/api/items/3/"},
+	} {
+		opened = ""
+		_, err := runCLIWithCapturedStdout(t, append([]string{"open"}, args...)...)
+		if err == nil || opened != "" {
+			t.Fatalf("open %v: expected the URL to be rejected, got %v after opening %q", args, err, opened)
+		}
+	}
+	if _, err := runCLIWithCapturedStdout(t, "open", "occurrence", "https://rollbar.com/acme/api/items/3/occurrences/501/"); err != nil || opened != "https://rollbar.com/acme/api/items/3/occurrences/501/" {
+		t.Fatalf("expected an occurrence link to open, got %v and %q", err, opened)
+	}
+}
@@ -1,11 +1,21 @@
 package cmd
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"os"
 	"slices"
 	"strings"
+	"text/template"
+	"time"
+
+	"github.com/itchyny/gojq"
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/ui"
 )
 
 const (
@@ -13,9 +23,24 @@ const (
 	outputJSON    = "json"
 	outputRawJSON = "raw-json"
 	outputNDJSON  = "ndjson"
+
+	// outputTemplate is accepted by --output but never returned by
+	// resolveOutputMode: it resolves to the structured mode the template is
+	// executed against.
+	outputTemplate = "template"
+
+	outputCSV      = ui.FormatCSV
+	outputTSV      = ui.FormatTSV
+	outputYAML     = ui.FormatYAML
+	outputMarkdown = ui.FormatMarkdown
 )
 
-func resolveOutputMode(output string, jsonShortcut bool, allowed ...string) (string, error) {
+// jsonSchemaVersion is reported as schema_version in --json list envelopes.
+// Bump it when a field is renamed, retyped, or removed; adding fields does not
+// change it.
+const jsonSchemaVersion = 1
+
+func resolveOutputMode(filter outputFilter, output string, jsonShortcut bool, allowed ...string) (string, error) {
 	if jsonShortcut {
 		output = outputJSON
 	}
@@ -23,13 +48,40 @@ func resolveOutputMode(output string, jsonShortcut bool, allowed ...string) (str
 	if output == "" {
 		output = outputText
 	}
+	if output == outputTemplate {
+		if filter.template == nil {
+			return "", fmt.Errorf("--output template requires --template")
+		}
+		output = outputText
+	}
 	if !slices.Contains(allowed, output) {
 		return "", fmt.Errorf("invalid --output %q (expected: %s)", output, strings.Join(allowed, "|"))
 	}
+	if filter.active() {
+		return filteredOutputMode(filter, output, allowed)
+	}
 	return output, nil
 }
 
-func resolveOutputModeWithAliases(output string, jsonShortcut bool, rawJSON bool, ndjson bool, allowed ...string) (string, error) {
+// filteredOutputMode swaps text output for the structured output --query and
+// --template work on. Templates prefer ndjson so they run once per record.
+func filteredOutputMode(filter outputFilter, output string, allowed []string) (string, error) {
+	if isTableOutput(output) {
+		return "", fmt.Errorf("--query and --template cannot be combined with --output %s", output)
+	}
+	if output != outputText {
+		return output, nil
+	}
+	if filter.template != nil && slices.Contains(allowed, outputNDJSON) {
+		return outputNDJSON, nil
+	}
+	if slices.Contains(allowed, outputJSON) {
+		return outputJSON, nil
+	}
+	return "", fmt.Errorf("this command does not support --query or --template")
+}
+
+func resolveOutputModeWithAliases(filter outputFilter, output string, jsonShortcut bool, rawJSON bool, ndjson bool, allowed ...string) (string, error) {
 	aliasCount := 0
 	if jsonShortcut {
 		aliasCount++
@@ -51,26 +103,37 @@ func resolveOutputModeWithAliases(output string, jsonShortcut bool, rawJSON bool
 	case jsonShortcut:
 		output = outputJSON
 	}
-	return resolveOutputMode(output, false, allowed...)
+	return resolveOutputMode(filter, output, false, allowed...)
 }
 
-func resolveOutput(output string, jsonShortcut bool, rawJSON bool, ndjson bool) (string, error) {
+func resolveOutput(filter outputFilter, output string, jsonShortcut bool, rawJSON bool, ndjson bool) (string, error) {
 	switch {
 	case rawJSON:
 		output = outputRawJSON
 	case ndjson:
 		output = outputNDJSON
 	}
-	return resolveOutputMode(output, jsonShortcut, outputText, outputJSON, outputRawJSON, outputNDJSON)
+	return resolveOutputMode(filter, output, jsonShortcut, outputText, outputJSON, outputRawJSON, outputNDJSON)
 }
 
-func writeJSON(v any) error {
+func writeJSON(filter outputFilter, v any) error {
+	if filter.active() {
+		return filter.write(v, true)
+	}
 	enc := json.NewEncoder(os.Stdout)
 	enc.SetIndent("", "  ")
 	return enc.Encode(v)
 }
 
-func writeNDJSON(items []any) error {
+func writeNDJSON(filter outputFilter, items []any) error {
+	if filter.active() {
+		for _, item := range items {
+			if err := filter.write(item, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	enc := json.NewEncoder(os.Stdout)
 	for _, item := range items {
 		if err := enc.Encode(item); err != nil {
@@ -80,7 +143,257 @@ func writeNDJSON(items []any) error {
 	return nil
 }
 
+func isTableOutput(output string) bool {
+	switch output {
+	case outputCSV, outputTSV, outputYAML, outputMarkdown:
+		return true
+	}
+	return false
+}
+
+func writeTable(output string, table ui.Table, noHeaders bool) error {
+	return ui.WriteTable(os.Stdout, output, table, ui.TableWriteOptions{NoHeaders: noHeaders})
+}
+
 func writeStdoutf(format string, args ...any) error {
 	_, err := fmt.Fprintf(os.Stdout, format, args...)
 	return err
 }
+
+// outputFilter holds the parsed --query and --template. The root command
+// builds it into cliConfig.Filter before each run, and commands hand it to
+// resolveOutputMode, writeJSON, and writeNDJSON. The zero value prints
+// output unchanged.
+type outputFilter struct {
+	query    *gojq.Code
+	template *template.Template
+	// rawStrings prints string query results without JSON quotes, like
+	// jq -r, unless a JSON output mode was asked for explicitly.
+	rawStrings bool
+}
+
+func configureOutputFilter(cmd *cobra.Command, cfg *cliConfig) error {
+	var filter outputFilter
+	if strings.TrimSpace(cfg.Query) != "" {
+		query, err := parseQuery(cfg.Query)
+		if err != nil {
+			return fmt.Errorf("invalid --query: %w", err)
+		}
+		filter.query = query
+	}
+	if cfg.Template != "" {
+		tmpl, err := template.New("output").Funcs(outputTemplateFuncs).Parse(cfg.Template)
+		if err != nil {
+			return fmt.Errorf("invalid --template: %w", err)
+		}
+		filter.template = tmpl
+	}
+	filter.rawStrings = filter.query != nil && filter.template == nil && !wantsJSONErrors(cmd)
+	cfg.Filter = filter
+	return nil
+}
+
+func (f outputFilter) active() bool {
+	return f.query != nil || f.template != nil
+}
+
+// write runs one JSON document through the query, then prints each result
+// with the template or as JSON. Both see the document as --json prints it,
+// so templates use the same snake_case fields with or without --query.
+func (f outputFilter) write(v any, indent bool) error {
+	generic, err := toGenericJSON(v)
+	if err != nil {
+		return err
+	}
+	results := []any{generic}
+	if f.query != nil {
+		if results, err = runQuery(f.query, generic); err != nil {
+			return fmt.Errorf("--query: %w", err)
+		}
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	if indent {
+		enc.SetIndent("", "  ")
+	}
+	for _, result := range results {
+		if f.template == nil {
+			if s, ok := result.(string); ok && f.rawStrings {
+				if _, err := fmt.Fprintln(os.Stdout, s); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := enc.Encode(result); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := f.template.Execute(&buf, result); err != nil {
+			return fmt.Errorf("--template: %w", err)
+		}
+		if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+		if _, err := os.Stdout.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseQuery compiles a jq filter. env and $ENV read the process
+// environment, as they do in jq.
+func parseQuery(src string) (*gojq.Code, error) {
+	query, err := gojq.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	return gojq.Compile(query, gojq.WithEnvironLoader(os.Environ))
+}
+
+// runQuery collects every result of the query. gojq reports errors as
+// values in the result stream; the first one stops the run.
+func runQuery(code *gojq.Code, input any) ([]any, error) {
+	var results []any
+	iter := code.Run(input)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			return results, nil
+		}
+		if err, ok := v.(error); ok {
+			var halt *gojq.HaltError
+			if errors.As(err, &halt) && halt.Value() == nil {
+				return results, nil
+			}
+			return nil, err
+		}
+		results = append(results, v)
+	}
+}
+
+// toGenericJSON round-trips v through encoding/json so the query and the
+// template see the same keys and values that --json prints. Numbers stay
+// json.Number so ids and timestamps print as integers in templates.
+func toGenericJSON(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
+
+var outputTemplateFuncs = template.FuncMap{
+	"timeago":  templateTimeAgo,
+	"time":     templateTime,
+	"truncate": templateTruncate,
+	"json":     templateJSON,
+	"upper":    func(v any) string { return strings.ToUpper(templateString(v)) },
+	"lower":    func(v any) string { return strings.ToLower(templateString(v)) },
+}
+
+// templateNow is swapped out by tests.
+var templateNow = time.Now
+
+func templateTime(v any) string {
+	t, ok := templateTimestamp(v)
+	if !ok {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func templateTimeAgo(v any) string {
+	t, ok := templateTimestamp(v)
+	if !ok {
+		return "-"
+	}
+	d := templateNow().Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+	var text string
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		text = fmt.Sprintf("%dm", int(d/time.Minute))
+	case d < 48*time.Hour:
+		text = fmt.Sprintf("%dh", int(d/time.Hour))
+	default:
+		text = fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+	}
+	if future {
+		return "in " + text
+	}
+	return text + " ago"
+}
+
+// templateTimestamp accepts unix seconds as the structs and --query results
+// carry them, or a time.Time. Zero means unset.
+func templateTimestamp(v any) (time.Time, bool) {
+	var seconds float64
+	switch value := v.(type) {
+	case time.Time:
+		return value, !value.IsZero()
+	case int64:
+		seconds = float64(value)
+	case int:
+		seconds = float64(value)
+	case float64:
+		seconds = value
+	case json.Number:
+		var err error
+		if seconds, err = value.Float64(); err != nil {
+			return time.Time{}, false
+		}
+	default:
+		return time.Time{}, false
+	}
+	if seconds <= 0 {
+		return time.Time{}, false
+	}
+	whole, frac := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(frac*1e9)), true
+}
+
+// templateTruncate takes the length first so it reads naturally in a
+// pipeline: {{.title | truncate 40}}.
+func templateTruncate(n int, v any) string {
+	s := templateString(v)
+	runes := []rune(s)
+	if n <= 0 || len(runes) <= n {
+		return s
+	}
+	if n == 1 {
+		return "…"
+	}
+	return string(runes[:n-1]) + "…"
+}
+
+func templateJSON(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// templateString prints a missing field as an empty string rather than
+// "<nil>".
+func templateString(v any) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
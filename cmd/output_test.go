@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+const queryTestItems = `{
+	"schema_version": 1,
+	"items": [
+		{"id": 1, "counter": 10, "level": "error", "title": "boom", "total_occurrences": 40, "environment": "production"},
+		{"id": 2, "counter": 11, "level": "warning", "title": "slow", "total_occurrences": 3, "environment": "staging"},
+		{"id": 3, "counter": 12, "level": "error", "title": "crash", "total_occurrences": 7, "environment": "production", "assigned_user": {"username": "ana"}}
+	]
+}`
+
+func TestRunQuery(t *testing.T) {
+	t.Setenv("ROLLBAR_QUERY_TEST", "on")
+
+	tests := []struct {
+		filter string
+		input  string
+		want   string
+	}{
+		{".", `{"a":1}`, `{"a":1}`},
+		{".items[-1].counter", queryTestItems, `12`},
+		{".items[].counter", queryTestItems, "10\n11\n12"},
+		{`[.items[] | select(.total_occurrences > 5)] | map(.id)`, queryTestItems, `[1,3]`},
+		{`.items | group_by(.environment) | map({env: .[0].environment, n: length})`, queryTestItems, `[{"env":"production","n":2},{"env":"staging","n":1}]`},
+		{`.items[] | .assigned_user.username // "-"`, queryTestItems, "\"-\"\n\"-\"\n\"ana\""},
+		{`.items[0] | with_entries(.value = 1) | keys`, queryTestItems, `["counter","environment","id","level","title","total_occurrences"]`},
+		{`.items[0] | with_entries(.value = 1) | add`, queryTestItems, `6`},
+		{`.items |= map(.counter)`, queryTestItems, `{"items":[10,11,12],"schema_version":1}`},
+		{`del(.items) `, queryTestItems, `{"schema_version":1}`},
+		{`[paths(type == "string")] | length`, queryTestItems, `10`},
+		{`getpath(["items", 2, "assigned_user", "username"])`, queryTestItems, `"ana"`},
+		{`[.items[0] | tostream] | length`, queryTestItems, `7`},
+		{`.items[0] as {title: $t, counter: $c} | "\($c) \($t)"`, queryTestItems, `"10 boom"`},
+		{`def hot: .total_occurrences > 5; [.items[] | select(hot) | .id]`, queryTestItems, `[1,3]`},
+		{`label $out | .items[] | if .id == 2 then ., break $out else . end | .id`, queryTestItems, "1\n2"},
+		{`"a,b, c" | index(","), indices(","), [splits(", *")]`, `null`, "1\n[1,3]\n[\"a\",\"b\",\"c\"]"},
+		{`"TimeoutError after 30s" | capture("(?<kind>\\w+) after (?<secs>\\d+)s")`, `null`, `{"kind":"TimeoutError","secs":"30"}`},
+		{`[scan("\\d+")]`, `"a1b22c333"`, `["1","22","333"]`},
+		{`any(.items[]; .level == "warning"), all(.items[]; .level == "error")`, queryTestItems, "true\nfalse"},
+		{`[.items[] | select(.level | IN("error", "critical")) | .id]`, queryTestItems, `[1,3]`},
+		{`[range(0; 10; 3)], nth(1; .items[].id), [limit(2; .items[].id)]`, queryTestItems, "[0,3,6,9]\n2\n[1,2]"},
+		{`0 | until(. >= 5; . + 2)`, `null`, `6`},
+		{`[[1,2],[3]] | transpose`, `null`, `[[1,3],[2,null]]`},
+		{`"abc" | explode | map(. + 1) | implode`, `null`, `"bcd"`},
+		{`env.ROLLBAR_QUERY_TEST, $ENV.ROLLBAR_QUERY_TEST`, `null`, "\"on\"\n\"on\""},
+		{`1700000000 | strftime("%Y-%m-%d")`, `null`, `"2023-11-14"`},
+		{`[recurse(.a?)] | length`, `{"a":{"a":1}}`, `3`},
+		{`.items | map([.counter, .title] | @tsv) | join("\n")`, queryTestItems, `"10\tboom\n11\tslow\n12\tcrash"`},
+		{`first(.items[]) | .title, halt`, queryTestItems, `"boom"`},
+	}
+	for _, tt := range tests {
+		code, err := parseQuery(tt.filter)
+		if err != nil {
+			t.Errorf("parseQuery(%q) error = %v", tt.filter, err)
+			continue
+		}
+		var input any
+		if err := json.Unmarshal([]byte(tt.input), &input); err != nil {
+			t.Fatalf("invalid input for %q: %v", tt.filter, err)
+		}
+		results, err := runQuery(code, input)
+		if err != nil {
+			t.Errorf("runQuery(%q) error = %v", tt.filter, err)
+			continue
+		}
+		lines := make([]string, 0, len(results))
+		for _, result := range results {
+			data, err := json.Marshal(result)
+			if err != nil {
+				t.Fatalf("runQuery(%q) returned unencodable %#v", tt.filter, result)
+			}
+			lines = append(lines, string(data))
+		}
+		if got := strings.Join(lines, "\n"); got != tt.want {
+			t.Errorf("runQuery(%q) = %s, want %s", tt.filter, got, tt.want)
+		}
+	}
+}
+
+func TestRunQueryErrors(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   string
+	}{
+		{`.items[] | .title + 1`, "cannot add"},
+		{`error("stop")`, "stop"},
+		{`.items | halt_error`, "halt error"},
+	}
+	for _, tt := range tests {
+		code, err := parseQuery(tt.filter)
+		if err != nil {
+			t.Fatalf("parseQuery(%q) error = %v", tt.filter, err)
+		}
+		var input any
+		if err := json.Unmarshal([]byte(queryTestItems), &input); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := runQuery(code, input); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("runQuery(%q) error = %v, want %q", tt.filter, err, tt.want)
+		}
+	}
+	if _, err := parseQuery(`.items[`); err == nil {
+		t.Fatal("expected a parse error")
+	}
+	if _, err := parseQuery(`undefined_fn`); err == nil || !strings.Contains(err.Error(), "undefined_fn") {
+		t.Fatalf("expected a compile error, got %v", err)
+	}
+}
+
+func TestTemplateTimeAgo(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	templateNow = func() time.Time { return now }
+	t.Cleanup(func() { templateNow = time.Now })
+
+	tests := []struct {
+		value any
+		want  string
+	}{
+		{value: int64(0), want: "-"},
+		{value: "soon", want: "-"},
+		{value: now.Unix() - 30, want: "just now"},
+		{value: float64(now.Unix() - 5*60), want: "5m ago"},
+		{value: json.Number("1699999400"), want: "10m ago"},
+		{value: now.Add(-3 * time.Hour), want: "3h ago"},
+		{value: now.Unix() - 3*86400, want: "3d ago"},
+		{value: now.Unix() + 2*3600, want: "in 2h"},
+	}
+	for _, tt := range tests {
+		if got := templateTimeAgo(tt.value); got != tt.want {
+			t.Errorf("templateTimeAgo(%v) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestTemplateTruncate(t *testing.T) {
+	if got := templateTruncate(5, "héllo world"); got != "héll…" {
+		t.Fatalf("unexpected truncation: %q", got)
+	}
+	if got := templateTruncate(20, "short"); got != "short" {
+		t.Fatalf("short strings should be unchanged: %q", got)
+	}
+}
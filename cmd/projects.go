@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+	"github.com/davebarnwell/rollbar-cli/internal/ui"
+)
+
+type projectsListOptions struct {
+	Output    string
+	JSON      bool
+	RawJSON   bool
+	NDJSON    bool
+	Fields    []string
+	NoHeaders bool
+}
+
+type projectsGetOptions struct {
+	ID      int64
+	Output  string
+	JSON    bool
+	RawJSON bool
+	NDJSON  bool
+	Fields  []string
+}
+
+type projectsCreateOptions struct {
+	Name    string
+	Output  string
+	JSON    bool
+	RawJSON bool
+	NDJSON  bool
+}
+
+type projectsDeleteOptions struct {
+	ID      int64
+	Output  string
+	JSON    bool
+	RawJSON bool
+	NDJSON  bool
+}
+
+type projectListJSONOutput struct {
+	SchemaVersion int               `json:"schema_version"`
+	Projects      []rollbar.Project `json:"projects"`
+}
+
+type projectGetJSONOutput struct {
+	Project rollbar.Project `json:"project"`
+}
+
+type projectDeleteJSONOutput struct {
+	Deleted   bool  `json:"deleted"`
+	ProjectID int64 `json:"project_id"`
+}
+
+func newProjectsCmd(cfg *cliConfig) *cobra.Command {
+	var (
+		listOpts   projectsListOptions
+		getOpts    projectsGetOptions
+		createOpts projectsCreateOptions
+		deleteOpts projectsDeleteOptions
+	)
+
+	projectsCmd := &cobra.Command{
+		Use:     "projects",
+		Aliases: []string{"project"},
+		Short:   "Manage Rollbar projects (requires an account token)",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List projects in the Rollbar account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireAccountToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, listOpts.Output, listOpts.JSON, listOpts.RawJSON, listOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			resp, err := client.ListProjects(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			switch output {
+			case outputRawJSON:
+				return writeJSON(cfg.Filter, resp.Raw)
+			case outputJSON:
+				return writeJSON(cfg.Filter, projectListJSONOutput{SchemaVersion: jsonSchemaVersion, Projects: resp.Projects})
+			case outputNDJSON:
+				records := make([]any, 0, len(resp.Projects))
+				for _, project := range resp.Projects {
+					records = append(records, project)
+				}
+				return writeNDJSON(cfg.Filter, records)
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.ProjectsTable(resp.Projects, normalizeFields(listOpts.Fields)), listOpts.NoHeaders)
+			default:
+				return ui.RenderProjectsWithOptions(resp.Projects, ui.ProjectRenderOptions{
+					Fields:    normalizeFields(listOpts.Fields),
+					NoHeaders: listOpts.NoHeaders,
+				})
+			}
+		},
+	}
+
+	getCmd := &cobra.Command{
+		Use:   "get [id]",
+		Short: "Get a project by ID",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireAccountToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, getOpts.Output, getOpts.JSON, getOpts.RawJSON, getOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+
+			id, err := resolveProjectID(cmd, args, getOpts.ID)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			resp, err := client.GetProject(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+			return writeSingleProjectOutput(cfg.Filter, resp.Project, resp.Raw, output, normalizeFields(getOpts.Fields))
+		},
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireAccountToken(cfg); err != nil {
+				return err
+			}
+
+			name := strings.TrimSpace(createOpts.Name)
+			if name == "" {
+				return fmt.Errorf("missing required flag: --name")
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, createOpts.Output, createOpts.JSON, createOpts.RawJSON, createOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			resp, err := client.CreateProject(cmd.Context(), name)
+			if err != nil {
+				return err
+			}
+			return writeSingleProjectOutput(cfg.Filter, resp.Project, resp.Raw, output, nil)
+		},
+	}
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete [id]",
+		Short: "Delete a project and all of its data",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireAccountToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, deleteOpts.Output, deleteOpts.JSON, deleteOpts.RawJSON, deleteOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			if err != nil {
+				return err
+			}
+
+			id, err := resolveProjectID(cmd, args, deleteOpts.ID)
+			if err != nil {
+				return err
+			}
+			if err := confirmAction(cmd, cfg, fmt.Sprintf("delete project %d and all of its data", id)); err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			raw, err := client.DeleteProject(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			switch output {
+			case outputRawJSON:
+				return writeJSON(cfg.Filter, raw)
+			case outputJSON:
+				return writeJSON(cfg.Filter, projectDeleteJSONOutput{Deleted: true, ProjectID: id})
+			case outputNDJSON:
+				return writeNDJSON(cfg.Filter, []any{projectDeleteJSONOutput{Deleted: true, ProjectID: id}})
+			default:
+				return writeStdoutf("Deleted project %d\n", id)
+			}
+		},
+	}
+
+	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
+	listCmd.Flags().BoolVar(&listOpts.JSON, "json", false, "Shortcut for --output json")
+	listCmd.Flags().BoolVar(&listOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	listCmd.Flags().BoolVar(&listOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text, csv, tsv, yaml, and markdown output")
+	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
+
+	getCmd.Flags().Int64Var(&getOpts.ID, "id", 0, "Project ID")
+	getCmd.Flags().StringVarP(&getOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
+	getCmd.Flags().BoolVar(&getOpts.JSON, "json", false, "Shortcut for --output json")
+	getCmd.Flags().BoolVar(&getOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	getCmd.Flags().BoolVar(&getOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	getCmd.Flags().StringSliceVar(&getOpts.Fields, "fields", nil, "Fields to render in csv, tsv, yaml, and markdown output")
+
+	createCmd.Flags().StringVar(&createOpts.Name, "name", "", "Project name")
+	createCmd.Flags().StringVarP(&createOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|template")
+	createCmd.Flags().BoolVar(&createOpts.JSON, "json", false, "Shortcut for --output json")
+	createCmd.Flags().BoolVar(&createOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	createCmd.Flags().BoolVar(&createOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+
+	deleteCmd.Flags().Int64Var(&deleteOpts.ID, "id", 0, "Project ID")
+	deleteCmd.Flags().StringVarP(&deleteOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|template")
+	deleteCmd.Flags().BoolVar(&deleteOpts.JSON, "json", false, "Shortcut for --output json")
+	deleteCmd.Flags().BoolVar(&deleteOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	deleteCmd.Flags().BoolVar(&deleteOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+
+	projectsCmd.AddCommand(listCmd, getCmd, createCmd, deleteCmd)
+	return projectsCmd
+}
+
+func writeSingleProjectOutput(filter outputFilter, project rollbar.Project, raw map[string]any, output string, fields []string) error {
+	switch output {
+	case outputRawJSON:
+		return writeJSON(filter, raw)
+	case outputJSON:
+		return writeJSON(filter, projectGetJSONOutput{Project: project})
+	case outputNDJSON:
+		return writeNDJSON(filter, []any{project})
+	case outputCSV, outputTSV, outputYAML, outputMarkdown:
+		return writeTable(output, ui.ProjectsTable([]rollbar.Project{project}, fields), false)
+	default:
+		return ui.RenderProject(project)
+	}
+}
+
+func resolveProjectID(cmd *cobra.Command, args []string, id int64) (int64, error) {
+	idSet := cmd.Flags().Changed("id")
+	arg := ""
+	if len(args) > 0 {
+		arg = args[0]
+	}
+
+	sources := 0
+	if arg != "" {
+		sources++
+	}
+	if idSet {
+		sources++
+	}
+	if sources == 0 {
+		return 0, fmt.Errorf("missing project identifier: pass [id] or --id")
+	}
+	if sources > 1 {
+		return 0, fmt.Errorf("provide only one project identifier: [id] or --id")
+	}
+
+	if arg != "" {
+		parsed, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil || parsed <= 0 {
+			return 0, fmt.Errorf("invalid project id %q: must be > 0", arg)
+		}
+		return parsed, nil
+	}
+
+	if id <= 0 {
+		return 0, fmt.Errorf("invalid project id: must be > 0")
+	}
+	return id, nil
+}
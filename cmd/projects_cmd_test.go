@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestProjectsListCommandUsesAccountToken(t *testing.T) {
+	var gotToken string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotToken = r.Header.Get("X-Rollbar-Access-Token")
+		_, _ = w.Write([]byte(`{"err":0,"result":[{"id":11,"account_id":3,"name":"web","status":"enabled"},{"id":12,"account_id":3,"name":"api","status":"disabled"}]}`))
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"projects", "list",
+		"--fields", "name,status",
+		"--no-headers",
+		"--token", "project-tok",
+		"--account-token", "account-tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if gotToken != "account-tok" {
+		t.Fatalf("expected account token header, got %q", gotToken)
+	}
+	if strings.Contains(out, "NAME") || !strings.Contains(out, "web") || !strings.Contains(out, "disabled") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestProjectsDeleteCommandRequiresYes(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"err":0,"result":{}}`))
+	}))
+	defer ts.Close()
+
+	_, err := runCLIWithCapturedStdout(t,
+		"projects", "delete", "12",
+		"--account-token", "account-tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "without --yes") {
+		t.Fatalf("expected confirmation error, got %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("expected no API calls, got %d", calls)
+	}
+
+	out, err := runCLIWithCapturedStdout(t,
+		"projects", "delete", "12",
+		"--yes",
+		"--json",
+		"--account-token", "account-tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if calls != 1 || !strings.Contains(out, "\"deleted\": true") {
+		t.Fatalf("unexpected output: calls=%d out=%q", calls, out)
+	}
+}
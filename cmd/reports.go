@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+	"github.com/davebarnwell/rollbar-cli/internal/ui"
+)
+
+const defaultReportHours = 24
+
+var validReportBucketSizes = map[time.Duration]struct{}{
+	time.Minute:    {},
+	time.Hour:      {},
+	24 * time.Hour: {},
+}
+
+type reportsTopActiveOptions struct {
+	Environments []string
+	Hours        int
+	Limit        int
+	Output       string
+	JSON         bool
+	RawJSON      bool
+	NDJSON       bool
+	NoHeaders    bool
+}
+
+type reportsCountsOptions struct {
+	Environment string
+	ItemID      int64
+	BucketSize  time.Duration
+	Hours       int
+	Buckets     int
+	Output      string
+	JSON        bool
+	RawJSON     bool
+	NDJSON      bool
+	NoHeaders   bool
+}
+
+type topActiveItemsJSONOutput struct {
+	SchemaVersion int                     `json:"schema_version"`
+	Items         []rollbar.TopActiveItem `json:"items"`
+}
+
+type countBucketsJSONOutput struct {
+	SchemaVersion int                   `json:"schema_version"`
+	Buckets       []rollbar.CountBucket `json:"buckets"`
+}
+
+func newReportsCmd(cfg *cliConfig) *cobra.Command {
+	var (
+		topOpts        reportsTopActiveOptions
+		occurrenceOpts reportsCountsOptions
+		activatedOpts  reportsCountsOptions
+	)
+
+	reportsCmd := &cobra.Command{
+		Use:     "reports",
+		Aliases: []string{"report"},
+		Short:   "Show item and occurrence metrics over time",
+	}
+
+	topActiveCmd := &cobra.Command{
+		Use:   "top-active",
+		Short: "List the most active items with an hourly trend",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, topOpts.Output, topOpts.JSON, topOpts.RawJSON, topOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+			if topOpts.Hours <= 0 {
+				return fmt.Errorf("--hours must be > 0")
+			}
+			if topOpts.Limit < 0 {
+				return fmt.Errorf("--limit must be >= 0")
+			}
+
+			client := newRollbarClient(cfg)
+			resp, err := client.TopActiveItems(cmd.Context(), rollbar.TopActiveItemsOptions{
+				Hours:        topOpts.Hours,
+				Environments: topOpts.Environments,
+			})
+			if err != nil {
+				return err
+			}
+
+			items := resp.Items
+			if topOpts.Limit > 0 && len(items) > topOpts.Limit {
+				items = items[:topOpts.Limit]
+			}
+
+			switch output {
+			case outputRawJSON:
+				return writeJSON(cfg.Filter, resp.Raw)
+			case outputJSON:
+				return writeJSON(cfg.Filter, topActiveItemsJSONOutput{SchemaVersion: jsonSchemaVersion, Items: items})
+			case outputNDJSON:
+				records := make([]any, 0, len(items))
+				for _, item := range items {
+					records = append(records, item)
+				}
+				return writeNDJSON(cfg.Filter, records)
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.TopActiveItemsTable(items), topOpts.NoHeaders)
+			default:
+				return ui.RenderTopActiveItems(items, ui.ReportRenderOptions{NoHeaders: topOpts.NoHeaders})
+			}
+		},
+	}
+
+	occurrenceCountsCmd := &cobra.Command{
+		Use:   "occurrence-counts",
+		Short: "Show occurrence counts per time bucket",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireToken(cfg); err != nil {
+				return err
+			}
+			return runCountsReport(cmd, cfg, occurrenceOpts, func(bucketSize int) (*rollbar.CountsResponse, error) {
+				return newRollbarClient(cfg).OccurrenceCounts(cmd.Context(), rollbar.OccurrenceCountsOptions{
+					BucketSize:  bucketSize,
+					Environment: occurrenceOpts.Environment,
+					ItemID:      occurrenceOpts.ItemID,
+				})
+			})
+		},
+	}
+
+	activatedCountsCmd := &cobra.Command{
+		Use:   "activated-counts",
+		Short: "Show counts of newly activated or reactivated items per time bucket",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireToken(cfg); err != nil {
+				return err
+			}
+			return runCountsReport(cmd, cfg, activatedOpts, func(bucketSize int) (*rollbar.CountsResponse, error) {
+				return newRollbarClient(cfg).ActivatedCounts(cmd.Context(), rollbar.ActivatedCountsOptions{
+					BucketSize:  bucketSize,
+					Environment: activatedOpts.Environment,
+				})
+			})
+		},
+	}
+
+	topActiveCmd.Flags().StringSliceVar(&topOpts.Environments, "environment", nil, "Environment filter (repeatable or comma-separated)")
+	topActiveCmd.Flags().IntVar(&topOpts.Hours, "hours", defaultReportHours, "Number of hours to report on")
+	topActiveCmd.Flags().IntVar(&topOpts.Limit, "limit", 0, "Maximum number of items to return")
+	topActiveCmd.Flags().StringVarP(&topOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
+	topActiveCmd.Flags().BoolVar(&topOpts.JSON, "json", false, "Shortcut for --output json")
+	topActiveCmd.Flags().BoolVar(&topOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	topActiveCmd.Flags().BoolVar(&topOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	topActiveCmd.Flags().BoolVar(&topOpts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
+
+	addCountsReportFlags(occurrenceCountsCmd, &occurrenceOpts)
+	occurrenceCountsCmd.Flags().Int64Var(&occurrenceOpts.ItemID, "item-id", 0, "Only count occurrences of this item ID")
+	addCountsReportFlags(activatedCountsCmd, &activatedOpts)
+
+	reportsCmd.AddCommand(topActiveCmd, occurrenceCountsCmd, activatedCountsCmd)
+	return reportsCmd
+}
+
+func addCountsReportFlags(cmd *cobra.Command, opts *reportsCountsOptions) {
+	cmd.Flags().StringVar(&opts.Environment, "environment", "", "Environment filter")
+	cmd.Flags().DurationVar(&opts.BucketSize, "bucket-size", time.Hour, "Bucket size: 1m|1h|24h")
+	cmd.Flags().IntVar(&opts.Hours, "hours", defaultReportHours, "Only show buckets from the last N hours")
+	cmd.Flags().IntVar(&opts.Buckets, "buckets", 0, "Only show the last N buckets (instead of --hours)")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Shortcut for --output json")
+	cmd.Flags().BoolVar(&opts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	cmd.Flags().BoolVar(&opts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	cmd.Flags().BoolVar(&opts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
+}
+
+func runCountsReport(cmd *cobra.Command, cfg *cliConfig, opts reportsCountsOptions, fetch func(bucketSize int) (*rollbar.CountsResponse, error)) error {
+	output, err := resolveOutputModeWithAliases(cfg.Filter, opts.Output, opts.JSON, opts.RawJSON, opts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+	if err != nil {
+		return err
+	}
+	if _, ok := validReportBucketSizes[opts.BucketSize]; !ok {
+		return fmt.Errorf("invalid --bucket-size %s: expected 1m|1h|24h", opts.BucketSize)
+	}
+	if cmd.Flags().Changed("hours") && cmd.Flags().Changed("buckets") {
+		return fmt.Errorf("use only one of --hours or --buckets")
+	}
+	if opts.Hours <= 0 {
+		return fmt.Errorf("--hours must be > 0")
+	}
+	if opts.Buckets < 0 {
+		return fmt.Errorf("--buckets must be >= 0")
+	}
+
+	resp, err := fetch(int(opts.BucketSize / time.Second))
+	if err != nil {
+		return err
+	}
+	buckets := windowCountBuckets(resp.Buckets, opts, time.Now())
+
+	switch output {
+	case outputRawJSON:
+		return writeJSON(cfg.Filter, resp.Raw)
+	case outputJSON:
+		return writeJSON(cfg.Filter, countBucketsJSONOutput{SchemaVersion: jsonSchemaVersion, Buckets: buckets})
+	case outputNDJSON:
+		records := make([]any, 0, len(buckets))
+		for _, bucket := range buckets {
+			records = append(records, bucket)
+		}
+		return writeNDJSON(cfg.Filter, records)
+	case outputCSV, outputTSV, outputYAML, outputMarkdown:
+		return writeTable(output, ui.CountBucketsTable(buckets), opts.NoHeaders)
+	default:
+		return ui.RenderCountBuckets(buckets, ui.ReportRenderOptions{NoHeaders: opts.NoHeaders})
+	}
+}
+
+// windowCountBuckets sorts buckets oldest first and keeps either the last
+// opts.Buckets entries or, when that is unset, those inside the --hours window.
+func windowCountBuckets(buckets []rollbar.CountBucket, opts reportsCountsOptions, now time.Time) []rollbar.CountBucket {
+	sorted := append([]rollbar.CountBucket(nil), buckets...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp < sorted[j].Timestamp
+	})
+
+	if opts.Buckets > 0 {
+		if len(sorted) > opts.Buckets {
+			sorted = sorted[len(sorted)-opts.Buckets:]
+		}
+		return sorted
+	}
+
+	// A bucket is kept if any part of it falls inside the window.
+	cutoff := now.Add(-time.Duration(opts.Hours)*time.Hour - opts.BucketSize).Unix()
+	windowed := make([]rollbar.CountBucket, 0, len(sorted))
+	for _, bucket := range sorted {
+		if bucket.Timestamp > cutoff {
+			windowed = append(windowed, bucket)
+		}
+	}
+	return windowed
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+func TestReportsOccurrenceCountsCommandNDJSON(t *testing.T) {
+	now := time.Now().Unix()
+	var gotQuery string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		_, _ = fmt.Fprintf(w, `{"err":0,"result":[[%d,1],[%d,2],[%d,3]]}`, now-2*3600, now-3600, now)
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"reports", "occurrence-counts",
+		"--environment", "production",
+		"--buckets", "2",
+		"--ndjson",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if !strings.Contains(gotQuery, "bucket_size=3600") || !strings.Contains(gotQuery, "environment=production") {
+		t.Fatalf("unexpected query: %q", gotQuery)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], `"count":2`) || !strings.Contains(lines[1], `"count":3`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestReportsCountsCommandValidation(t *testing.T) {
+	_, err := runCLIWithCapturedStdout(t, "reports", "activated-counts", "--bucket-size", "2h", "--token", "tok")
+	if err == nil || !strings.Contains(err.Error(), "invalid --bucket-size") {
+		t.Fatalf("expected bucket size error, got %v", err)
+	}
+
+	_, err = runCLIWithCapturedStdout(t, "reports", "activated-counts", "--hours", "2", "--buckets", "3", "--token", "tok")
+	if err == nil || !strings.Contains(err.Error(), "only one of --hours or --buckets") {
+		t.Fatalf("expected conflicting window error, got %v", err)
+	}
+}
+
+func TestReportsTopActiveCommandText(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"err":0,"result":[
+			{"item":{"id":1,"counter":12,"title":"boom","level":"error","occurrences":30},"counts":[1,5,9]},
+			{"item":{"id":2,"counter":13,"title":"quiet","level":"warning","occurrences":1},"counts":[0,0,1]}
+		]}`))
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"reports", "top-active",
+		"--limit", "1",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if !strings.Contains(out, "boom") || strings.Contains(out, "quiet") || !strings.Contains(out, "TREND") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestWindowCountBucketsByHours(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	buckets := []rollbar.CountBucket{
+		{Timestamp: now.Unix(), Count: 3},
+		{Timestamp: now.Add(-5 * time.Hour).Unix(), Count: 1},
+		{Timestamp: now.Add(-time.Hour).Unix(), Count: 2},
+	}
+
+	got := windowCountBuckets(buckets, reportsCountsOptions{Hours: 2, BucketSize: time.Hour}, now)
+	if len(got) != 2 || got[0].Count != 2 || got[1].Count != 3 {
+		t.Fatalf("unexpected windowed buckets: %#v", got)
+	}
+}
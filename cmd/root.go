@@ -12,18 +12,36 @@ import (
 const (
 	defaultBaseURL = "https://api.rollbar.com"
 	defaultTimeout = 15 * time.Second
+
+	defaultMaxAttempts  = 4
+	defaultRetryMaxWait = 60 * time.Second
 )
 
 type cliConfig struct {
-	Token      string
-	BaseURL    string
-	Timeout    time.Duration
-	ConfigPath string
-	Profile    string
+	Token        string
+	AccountToken string
+	BaseURL      string
+	Timeout      time.Duration
+	ConfigPath   string
+	Profile      string
+	Retries      bool
+	MaxAttempts  int
+	RetryMaxWait time.Duration
+	DryRun       bool
+	Yes          bool
+	Query        string
+	Template     string
+	PathRewrites []string
+	ProjectSlug  string
+	Filter       outputFilter
 }
 
 func Execute() error {
-	return newRootCmd().Execute()
+	executed, err := newRootCmd().ExecuteC()
+	if err != nil && wantsJSONErrors(executed) {
+		return &jsonOutputError{err: err}
+	}
+	return err
 }
 
 func newRootCmd() *cobra.Command {
@@ -36,23 +54,44 @@ func newRootCmd() *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
-			return applyConfigDefaults(cmd, cfg)
+			if err := applyConfigDefaults(cmd, cfg); err != nil {
+				return err
+			}
+			return configureOutputFilter(cmd, cfg)
 		},
 	}
 
 	rootCmd.PersistentFlags().StringVar(&cfg.Token, "token", "", "Rollbar access token (or set ROLLBAR_ACCESS_TOKEN)")
+	rootCmd.PersistentFlags().StringVar(&cfg.AccountToken, "account-token", "", "Rollbar account access token for account-level commands (or set ROLLBAR_ACCOUNT_ACCESS_TOKEN)")
 	rootCmd.PersistentFlags().StringVar(&cfg.BaseURL, "base-url", defaultBaseURL, "Rollbar API base URL")
 	rootCmd.PersistentFlags().DurationVar(&cfg.Timeout, "timeout", defaultTimeout, "HTTP timeout")
 	rootCmd.PersistentFlags().StringVar(&cfg.ConfigPath, "config", "", "Path to a rollbar-cli JSON config file")
 	rootCmd.PersistentFlags().StringVar(&cfg.Profile, "profile", "", "Config profile to use")
+	rootCmd.PersistentFlags().BoolVar(&cfg.Retries, "retries", true, "Retry rate-limited and transient API failures")
+	rootCmd.PersistentFlags().IntVar(&cfg.MaxAttempts, "max-attempts", defaultMaxAttempts, "Maximum attempts per API request when retries are enabled")
+	rootCmd.PersistentFlags().DurationVar(&cfg.RetryMaxWait, "retry-max-wait", defaultRetryMaxWait, "Maximum wait between retries, including rate-limit resets")
+	rootCmd.PersistentFlags().BoolVar(&cfg.DryRun, "dry-run", false, "Print mutating API requests instead of sending them")
+	rootCmd.PersistentFlags().BoolVar(&cfg.Yes, "yes", false, "Skip confirmation prompts for bulk, production, and destructive changes")
+	rootCmd.PersistentFlags().StringVar(&cfg.Query, "query", "", "jq filter applied to JSON output before printing (text output switches to json)")
+	rootCmd.PersistentFlags().StringVar(&cfg.Template, "template", "", "Go text/template rendered for each record; fields are the snake_case --json keys (e.g. '{{.id}} {{.title}}'); funcs: timeago, time, truncate, json, upper, lower")
 
 	rootCmd.AddCommand(newItemsCmd(cfg))
 	rootCmd.AddCommand(newOccurrencesCmd(cfg))
 	rootCmd.AddCommand(newDeploysCmd(cfg))
 	rootCmd.AddCommand(newEnvironmentsCmd(cfg))
 	rootCmd.AddCommand(newUsersCmd(cfg))
+	rootCmd.AddCommand(newProjectsCmd(cfg))
+	rootCmd.AddCommand(newTokensCmd(cfg))
+	rootCmd.AddCommand(newTeamsCmd(cfg))
+	rootCmd.AddCommand(newRQLCmd(cfg))
+	rootCmd.AddCommand(newReportsCmd(cfg))
+	rootCmd.AddCommand(newOpenCmd(cfg))
+	rootCmd.AddCommand(newHistoryCmd(cfg))
+	rootCmd.AddCommand(newUndoCmd(cfg))
+	rootCmd.AddCommand(newSchemaCmd(cfg))
 	rootCmd.AddCommand(newCompletionCmd())
 
+	wrapDryRun(rootCmd, cfg)
 	return rootCmd
 }
 
@@ -65,3 +104,22 @@ func requireToken(cfg *cliConfig) error {
 	}
 	return nil
 }
+
+// requireAccountToken resolves the token used for account-scoped endpoints.
+// It falls back to the project token so a single account token passed via
+// --token keeps working.
+func requireAccountToken(cfg *cliConfig) error {
+	if cfg.AccountToken == "" {
+		cfg.AccountToken = strings.TrimSpace(os.Getenv("ROLLBAR_ACCOUNT_ACCESS_TOKEN"))
+	}
+	if cfg.AccountToken == "" {
+		if cfg.Token == "" {
+			cfg.Token = strings.TrimSpace(os.Getenv("ROLLBAR_ACCESS_TOKEN"))
+		}
+		cfg.AccountToken = cfg.Token
+	}
+	if cfg.AccountToken == "" {
+		return fmt.Errorf("missing Rollbar account token: pass --account-token, set ROLLBAR_ACCOUNT_ACCESS_TOKEN, or configure account_token in a profile")
+	}
+	return nil
+}
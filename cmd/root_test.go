@@ -3,6 +3,7 @@ package cmd
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -66,3 +67,80 @@ func TestApplyConfigDefaultsProfile(t *testing.T) {
 		t.Fatalf("unexpected timeout: %s", cfg.Timeout)
 	}
 }
+
+func TestApplyConfigDefaultsProfileRetrySettings(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"default_profile":"ci","profiles":{"ci":{"token":"tok","retries":false,"max_attempts":6,"retry_max_wait":"2m"}}}`), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	cfg := &cliConfig{
+		BaseURL:      defaultBaseURL,
+		Timeout:      defaultTimeout,
+		ConfigPath:   configPath,
+		Retries:      true,
+		MaxAttempts:  defaultMaxAttempts,
+		RetryMaxWait: defaultRetryMaxWait,
+	}
+	if err := applyConfigDefaults(newRootCmd(), cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Retries || cfg.MaxAttempts != 6 || cfg.RetryMaxWait.String() != "2m0s" {
+		t.Fatalf("unexpected retry settings: %#v", cfg)
+	}
+
+	for _, wait := range []string{"0s", "-5s"} {
+		if err := os.WriteFile(configPath, []byte(`{"default_profile":"ci","profiles":{"ci":{"token":"tok","retry_max_wait":"`+wait+`"}}}`), 0o644); err != nil {
+			t.Fatalf("write config: %v", err)
+		}
+		cfg := &cliConfig{BaseURL: defaultBaseURL, Timeout: defaultTimeout, ConfigPath: configPath, RetryMaxWait: defaultRetryMaxWait}
+		if err := applyConfigDefaults(newRootCmd(), cfg); err == nil || !strings.Contains(err.Error(), "retry_max_wait") || !strings.Contains(err.Error(), "must be > 0") {
+			t.Fatalf("expected retry_max_wait %s to be rejected, got %v", wait, err)
+		}
+	}
+}
+
+func TestRequireAccountToken(t *testing.T) {
+	t.Setenv("ROLLBAR_ACCOUNT_ACCESS_TOKEN", "")
+	t.Setenv("ROLLBAR_ACCESS_TOKEN", "")
+
+	cfg := &cliConfig{Token: "project-token"}
+	if err := requireAccountToken(cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.AccountToken != "project-token" {
+		t.Fatalf("expected fallback to --token, got %q", cfg.AccountToken)
+	}
+
+	t.Setenv("ROLLBAR_ACCOUNT_ACCESS_TOKEN", "account-env")
+	cfg = &cliConfig{Token: "project-token"}
+	if err := requireAccountToken(cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.AccountToken != "account-env" {
+		t.Fatalf("expected account token from env, got %q", cfg.AccountToken)
+	}
+
+	t.Setenv("ROLLBAR_ACCOUNT_ACCESS_TOKEN", "")
+	if err := requireAccountToken(&cliConfig{}); err == nil {
+		t.Fatal("expected missing account token error")
+	}
+}
+
+func TestApplyConfigDefaultsProfileAccountToken(t *testing.T) {
+	t.Setenv("ROLLBAR_ACCOUNT_ACCESS_TOKEN", "")
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"default_profile":"admin","profiles":{"admin":{"token":"tok","account_token":"account-tok"}}}`), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	cfg := &cliConfig{BaseURL: defaultBaseURL, Timeout: defaultTimeout, ConfigPath: configPath}
+	if err := applyConfigDefaults(newRootCmd(), cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.AccountToken != "account-tok" || cfg.Token != "tok" {
+		t.Fatalf("unexpected tokens: %#v", cfg)
+	}
+}
@@ -0,0 +1,459 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+	"github.com/davebarnwell/rollbar-cli/internal/ui"
+)
+
+const (
+	defaultRQLPollInterval = 2 * time.Second
+	defaultRQLWaitTimeout  = 5 * time.Minute
+)
+
+type rqlRunOptions struct {
+	File         string
+	ForceRefresh bool
+	NoWait       bool
+	PollInterval time.Duration
+	WaitTimeout  time.Duration
+	Output       string
+	JSON         bool
+	RawJSON      bool
+	NDJSON       bool
+	NoHeaders    bool
+}
+
+type rqlJobsListOptions struct {
+	Pagination paginationOptions
+	Output     string
+	JSON       bool
+	RawJSON    bool
+	NDJSON     bool
+	Fields     []string
+	NoHeaders  bool
+}
+
+type rqlJobsGetOptions struct {
+	ID        int64
+	Result    bool
+	Output    string
+	JSON      bool
+	RawJSON   bool
+	NDJSON    bool
+	Fields    []string
+	NoHeaders bool
+}
+
+type rqlJobsCancelOptions struct {
+	ID      int64
+	Output  string
+	JSON    bool
+	RawJSON bool
+	NDJSON  bool
+}
+
+type rqlJobJSONOutput struct {
+	Job rollbar.RQLJob `json:"job"`
+}
+
+type rqlJobListJSONOutput struct {
+	SchemaVersion int              `json:"schema_version"`
+	Jobs          []rollbar.RQLJob `json:"jobs"`
+}
+
+type rqlResultJSONOutput struct {
+	Job    rollbar.RQLJob    `json:"job"`
+	Result rollbar.RQLResult `json:"result"`
+}
+
+func newRQLCmd(cfg *cliConfig) *cobra.Command {
+	var (
+		runOpts    rqlRunOptions
+		listOpts   rqlJobsListOptions
+		getOpts    rqlJobsGetOptions
+		cancelOpts rqlJobsCancelOptions
+	)
+
+	rqlCmd := &cobra.Command{
+		Use:   "rql",
+		Short: "Run Rollbar Query Language (RQL) jobs",
+	}
+
+	runCmd := &cobra.Command{
+		Use:   "run [query]",
+		Short: "Submit an RQL query, wait for it to finish, and print the result set",
+		Example: `  rollbar-cli rql run "SELECT request.url, count(*) FROM item_occurrence WHERE item.counter = 42 GROUP BY 1 ORDER BY 2 DESC LIMIT 20"
+  rollbar-cli rql run --file query.rql --ndjson`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, runOpts.Output, runOpts.JSON, runOpts.RawJSON, runOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+
+			query, err := resolveRQLQuery(args, runOpts.File, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			if runOpts.PollInterval <= 0 {
+				return fmt.Errorf("--poll-interval must be > 0")
+			}
+
+			client := newRollbarClient(cfg)
+			created, err := client.CreateRQLJob(cmd.Context(), rollbar.CreateRQLJobOptions{
+				QueryString:  query,
+				ForceRefresh: runOpts.ForceRefresh,
+			})
+			if err != nil {
+				return err
+			}
+			if created.Job.ID <= 0 {
+				return fmt.Errorf("rollbar did not return an RQL job id")
+			}
+			if runOpts.NoWait {
+				return writeRQLJobOutput(cfg.Filter, created.Job, created.Raw, output, nil)
+			}
+
+			if _, err := waitForRQLJob(cmd.Context(), client, created.Job.ID, runOpts.PollInterval, runOpts.WaitTimeout); err != nil {
+				return err
+			}
+
+			resp, err := client.GetRQLJobResult(cmd.Context(), created.Job.ID)
+			if err != nil {
+				return err
+			}
+			return writeRQLResultOutput(cfg.Filter, resp, output, runOpts.NoHeaders)
+		},
+	}
+
+	jobsCmd := &cobra.Command{
+		Use:   "jobs",
+		Short: "Inspect and manage RQL jobs",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List RQL jobs in the project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, listOpts.Output, listOpts.JSON, listOpts.RawJSON, listOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+			pagerOpts, err := listOpts.Pagination.pagerOptions(1)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarClient(cfg)
+			jobs, rawPages, err := collectPages(cmd.Context(), client.RQLJobsPager(pagerOpts), 0)
+			if err != nil {
+				return err
+			}
+
+			switch output {
+			case outputRawJSON:
+				return writeJSON(cfg.Filter, rawPagesOutput(rawPages))
+			case outputJSON:
+				return writeJSON(cfg.Filter, rqlJobListJSONOutput{SchemaVersion: jsonSchemaVersion, Jobs: jobs})
+			case outputNDJSON:
+				records := make([]any, 0, len(jobs))
+				for _, job := range jobs {
+					records = append(records, job)
+				}
+				return writeNDJSON(cfg.Filter, records)
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.RQLJobsTable(jobs, normalizeFields(listOpts.Fields)), listOpts.NoHeaders)
+			default:
+				return ui.RenderRQLJobs(jobs, ui.RQLJobRenderOptions{
+					Fields:    normalizeFields(listOpts.Fields),
+					NoHeaders: listOpts.NoHeaders,
+				})
+			}
+		},
+	}
+
+	getCmd := &cobra.Command{
+		Use:   "get [id]",
+		Short: "Get an RQL job by ID",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, getOpts.Output, getOpts.JSON, getOpts.RawJSON, getOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+
+			id, err := resolveRQLJobID(cmd, args, getOpts.ID)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarClient(cfg)
+			if getOpts.Result {
+				resp, err := client.GetRQLJobResult(cmd.Context(), id)
+				if err != nil {
+					return err
+				}
+				return writeRQLResultOutput(cfg.Filter, resp, output, getOpts.NoHeaders)
+			}
+
+			resp, err := client.GetRQLJob(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+			return writeRQLJobOutput(cfg.Filter, resp.Job, resp.Raw, output, normalizeFields(getOpts.Fields))
+		},
+	}
+
+	cancelCmd := &cobra.Command{
+		Use:   "cancel [id]",
+		Short: "Cancel a queued or running RQL job",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, cancelOpts.Output, cancelOpts.JSON, cancelOpts.RawJSON, cancelOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			if err != nil {
+				return err
+			}
+
+			id, err := resolveRQLJobID(cmd, args, cancelOpts.ID)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarClient(cfg)
+			resp, err := client.CancelRQLJob(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+			return writeRQLJobOutput(cfg.Filter, resp.Job, resp.Raw, output, nil)
+		},
+	}
+
+	runCmd.Flags().StringVar(&runOpts.File, "file", "", "Read the query from a file (use - for stdin)")
+	runCmd.Flags().BoolVar(&runOpts.ForceRefresh, "force-refresh", false, "Run the query even if a cached result exists")
+	runCmd.Flags().BoolVar(&runOpts.NoWait, "no-wait", false, "Submit the job and print it without waiting for results")
+	runCmd.Flags().DurationVar(&runOpts.PollInterval, "poll-interval", defaultRQLPollInterval, "How often to check the job status")
+	runCmd.Flags().DurationVar(&runOpts.WaitTimeout, "wait-timeout", defaultRQLWaitTimeout, "Maximum time to wait for the job to finish (0 waits forever)")
+	runCmd.Flags().StringVarP(&runOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
+	runCmd.Flags().BoolVar(&runOpts.JSON, "json", false, "Shortcut for --output json")
+	runCmd.Flags().BoolVar(&runOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	runCmd.Flags().BoolVar(&runOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	runCmd.Flags().BoolVar(&runOpts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
+
+	addPaginationFlags(listCmd.Flags(), &listOpts.Pagination, 1)
+	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
+	listCmd.Flags().BoolVar(&listOpts.JSON, "json", false, "Shortcut for --output json")
+	listCmd.Flags().BoolVar(&listOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	listCmd.Flags().BoolVar(&listOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text, csv, tsv, yaml, and markdown output")
+	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
+
+	getCmd.Flags().Int64Var(&getOpts.ID, "id", 0, "RQL job ID")
+	getCmd.Flags().BoolVar(&getOpts.Result, "result", false, "Fetch the job's result set")
+	getCmd.Flags().StringVarP(&getOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
+	getCmd.Flags().BoolVar(&getOpts.JSON, "json", false, "Shortcut for --output json")
+	getCmd.Flags().BoolVar(&getOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	getCmd.Flags().BoolVar(&getOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	getCmd.Flags().StringSliceVar(&getOpts.Fields, "fields", nil, "Job fields to render in csv, tsv, yaml, and markdown output")
+	getCmd.Flags().BoolVar(&getOpts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
+
+	cancelCmd.Flags().Int64Var(&cancelOpts.ID, "id", 0, "RQL job ID")
+	cancelCmd.Flags().StringVarP(&cancelOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|template")
+	cancelCmd.Flags().BoolVar(&cancelOpts.JSON, "json", false, "Shortcut for --output json")
+	cancelCmd.Flags().BoolVar(&cancelOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	cancelCmd.Flags().BoolVar(&cancelOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+
+	jobsCmd.AddCommand(listCmd, getCmd, cancelCmd)
+	rqlCmd.AddCommand(runCmd, jobsCmd)
+	return rqlCmd
+}
+
+func resolveRQLQuery(args []string, file string, stdin io.Reader) (string, error) {
+	file = strings.TrimSpace(file)
+	if len(args) > 0 && file != "" {
+		return "", fmt.Errorf("provide only one query source: [query] or --file")
+	}
+
+	var query string
+	switch {
+	case len(args) > 0:
+		query = args[0]
+	case file == "-":
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return "", fmt.Errorf("read query from stdin: %w", err)
+		}
+		query = string(data)
+	case file != "":
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("read query file: %w", err)
+		}
+		query = string(data)
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return "", fmt.Errorf("missing RQL query: pass [query] or --file")
+	}
+	return query, nil
+}
+
+func waitForRQLJob(ctx context.Context, client *rollbar.Client, id int64, interval time.Duration, timeout time.Duration) (rollbar.RQLJob, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		resp, err := client.GetRQLJob(ctx, id)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return rollbar.RQLJob{}, rqlWaitTimeoutError(id, timeout)
+			}
+			return rollbar.RQLJob{}, err
+		}
+
+		job := resp.Job
+		if job.Finished() {
+			if job.Status != rollbar.RQLStatusSuccess {
+				return job, rqlJobFailedError(ctx, client, job)
+			}
+			return job, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return job, rqlWaitTimeoutError(id, timeout)
+			}
+			return job, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func rqlWaitTimeoutError(id int64, timeout time.Duration) error {
+	return fmt.Errorf("RQL job %d did not finish within %s; check it later with `rollbar-cli rql jobs get %d`", id, timeout, id)
+}
+
+func rqlJobFailedError(ctx context.Context, client *rollbar.Client, job rollbar.RQLJob) error {
+	if job.Status == rollbar.RQLStatusFailed {
+		if resp, err := client.GetRQLJobResult(ctx, job.ID); err == nil && len(resp.Result.Errors) > 0 {
+			return fmt.Errorf("RQL job %d failed: %s", job.ID, strings.Join(resp.Result.Errors, "; "))
+		}
+	}
+	return fmt.Errorf("RQL job %d finished with status %q", job.ID, job.Status)
+}
+
+func writeRQLJobOutput(filter outputFilter, job rollbar.RQLJob, raw map[string]any, output string, fields []string) error {
+	switch output {
+	case outputRawJSON:
+		return writeJSON(filter, raw)
+	case outputJSON:
+		return writeJSON(filter, rqlJobJSONOutput{Job: job})
+	case outputNDJSON:
+		return writeNDJSON(filter, []any{job})
+	case outputCSV, outputTSV, outputYAML, outputMarkdown:
+		return writeTable(output, ui.RQLJobsTable([]rollbar.RQLJob{job}, fields), false)
+	default:
+		return ui.RenderRQLJob(job)
+	}
+}
+
+func writeRQLResultOutput(filter outputFilter, resp *rollbar.RQLJobResultResponse, output string, noHeaders bool) error {
+	switch output {
+	case outputRawJSON:
+		return writeJSON(filter, resp.Raw)
+	case outputJSON:
+		return writeJSON(filter, rqlResultJSONOutput{Job: resp.Job, Result: resp.Result})
+	case outputNDJSON:
+		return writeNDJSON(filter, rqlRowRecords(resp.Result))
+	case outputCSV, outputTSV, outputYAML, outputMarkdown:
+		return writeTable(output, ui.RQLResultTable(resp.Result), noHeaders)
+	default:
+		return ui.RenderRQLResult(resp.Result, ui.RQLResultRenderOptions{NoHeaders: noHeaders})
+	}
+}
+
+// rqlRowRecords turns positional result rows into one object per row keyed by
+// column name, which is what line-oriented consumers expect.
+func rqlRowRecords(result rollbar.RQLResult) []any {
+	records := make([]any, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		record := make(map[string]any, len(row))
+		for idx, value := range row {
+			name := "col" + strconv.Itoa(idx)
+			if idx < len(result.Columns) && result.Columns[idx] != "" {
+				name = result.Columns[idx]
+			}
+			record[name] = value
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func resolveRQLJobID(cmd *cobra.Command, args []string, id int64) (int64, error) {
+	idSet := cmd.Flags().Changed("id")
+	arg := ""
+	if len(args) > 0 {
+		arg = args[0]
+	}
+
+	sources := 0
+	if arg != "" {
+		sources++
+	}
+	if idSet {
+		sources++
+	}
+	if sources == 0 {
+		return 0, fmt.Errorf("missing RQL job identifier: pass [id] or --id")
+	}
+	if sources > 1 {
+		return 0, fmt.Errorf("provide only one RQL job identifier: [id] or --id")
+	}
+
+	if arg != "" {
+		parsed, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil || parsed <= 0 {
+			return 0, fmt.Errorf("invalid RQL job id %q: must be > 0", arg)
+		}
+		return parsed, nil
+	}
+
+	if id <= 0 {
+		return 0, fmt.Errorf("invalid RQL job id: must be > 0")
+	}
+	return id, nil
+}
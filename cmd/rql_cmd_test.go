@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRQLRunCommandPollsUntilComplete(t *testing.T) {
+	var polls int
+	var gotQuery string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/1/rql/jobs":
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			gotQuery, _ = body["query_string"].(string)
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":77,"status":"new"}}`))
+		case r.URL.Path == "/api/1/rql/job/77":
+			polls++
+			status := "running"
+			if polls >= 2 {
+				status = "success"
+			}
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":77,"status":"` + status + `"}}`))
+		case r.URL.Path == "/api/1/rql/job/77/result":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":77,"status":"success","result":{"selectionColumns":["request.url","count(*)"],"rows":[["/checkout",12],["/cart",3]]}}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"rql", "run", "SELECT request.url, count(*) FROM item_occurrence GROUP BY 1",
+		"--ndjson",
+		"--poll-interval", "1ms",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if !strings.HasPrefix(gotQuery, "SELECT request.url") {
+		t.Fatalf("unexpected query: %q", gotQuery)
+	}
+	if polls != 2 {
+		t.Fatalf("expected 2 status polls, got %d", polls)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], `"request.url":"/checkout"`) || !strings.Contains(lines[0], `"count(*)":12`) {
+		t.Fatalf("unexpected ndjson output: %q", out)
+	}
+}
+
+func TestRQLRunCommandReportsFailedJob(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":77,"status":"new"}}`))
+		case r.URL.Path == "/api/1/rql/job/77":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":77,"status":"failed"}}`))
+		case r.URL.Path == "/api/1/rql/job/77/result":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":77,"status":"failed","result":{"errors":["unknown column foo"]}}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	_, err := runCLIWithCapturedStdout(t,
+		"rql", "run", "SELECT foo FROM item_occurrence",
+		"--poll-interval", "1ms",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "unknown column foo") {
+		t.Fatalf("expected failed job error, got %v", err)
+	}
+}
+
+func TestRQLRunCommandRequiresQuery(t *testing.T) {
+	_, err := runCLIWithCapturedStdout(t, "rql", "run", "--token", "tok")
+	if err == nil || !strings.Contains(err.Error(), "missing RQL query") {
+		t.Fatalf("expected missing query error, got %v", err)
+	}
+}
+
+func TestRQLJobsCancelCommandJSON(t *testing.T) {
+	var gotMethod, gotPath string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		_, _ = w.Write([]byte(`{"err":0,"result":{"id":77,"status":"cancelled"}}`))
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"rql", "jobs", "cancel", "77",
+		"--json",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if gotMethod != http.MethodPost || gotPath != "/api/1/rql/job/77/cancel" {
+		t.Fatalf("unexpected request: %s %s", gotMethod, gotPath)
+	}
+	if !strings.Contains(out, "\"job\"") || !strings.Contains(out, "\"status\": \"cancelled\"") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+	"github.com/davebarnwell/rollbar-cli/internal/ui"
+)
+
+// stdinIsInteractive reports whether a confirmation prompt can be answered.
+// Tests replace it to simulate a terminal.
+var stdinIsInteractive = func() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+type dryRunJSONOutput struct {
+	DryRun  bool            `json:"dry_run"`
+	Method  string          `json:"method"`
+	Path    string          `json:"path"`
+	Query   string          `json:"query,omitempty"`
+	Body    json.RawMessage `json:"body,omitempty"`
+	Current *rollbar.Item   `json:"current,omitempty"`
+}
+
+// wrapDryRun makes every command print the request a dry-run client refused
+// to send, rather than failing with the *rollbar.DryRunError.
+func wrapDryRun(cmd *cobra.Command, cfg *cliConfig) {
+	for _, child := range cmd.Commands() {
+		wrapDryRun(child, cfg)
+	}
+	if cmd.RunE == nil {
+		return
+	}
+	run := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := run(cmd, args)
+		var plan *rollbar.DryRunError
+		if errors.As(err, &plan) {
+			return writeDryRunPlan(cfg.Filter, plan, nil, wantsJSONErrors(cmd))
+		}
+		return err
+	}
+}
+
+func writeDryRunPlan(filter outputFilter, plan *rollbar.DryRunError, current *rollbar.Item, jsonOutput bool) error {
+	if jsonOutput {
+		return writeJSON(filter, dryRunJSONOutput{
+			DryRun:  true,
+			Method:  plan.Method,
+			Path:    plan.Path,
+			Query:   plan.Query.Encode(),
+			Body:    plan.Body,
+			Current: current,
+		})
+	}
+
+	target := plan.Path
+	if query := plan.Query.Encode(); query != "" {
+		target += "?" + query
+	}
+	if err := writeStdoutf("Dry run: %s %s\n", plan.Method, target); err != nil {
+		return err
+	}
+	if len(plan.Body) > 0 {
+		var body bytes.Buffer
+		if err := json.Indent(&body, plan.Body, "", "  "); err != nil {
+			return err
+		}
+		if err := writeStdoutf("%s\n", body.String()); err != nil {
+			return err
+		}
+	}
+	if current == nil {
+		return nil
+	}
+	if err := writeStdoutf("\nCurrent state:\n"); err != nil {
+		return err
+	}
+	return ui.RenderItem(*current)
+}
+
+// confirmAction asks before bulk, production, and destructive changes.
+// --yes and --dry-run skip the prompt; without a terminal to ask on, the
+// change is refused unless --yes was passed.
+func confirmAction(cmd *cobra.Command, cfg *cliConfig, action string) error {
+	if cfg.Yes || cfg.DryRun {
+		return nil
+	}
+	if !stdinIsInteractive() {
+		return fmt.Errorf("refusing to %s without --yes", action)
+	}
+
+	if _, err := fmt.Fprintf(cmd.ErrOrStderr(), "About to %s. Continue? [y/N] ", action); err != nil {
+		return err
+	}
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && answer == "" {
+		return fmt.Errorf("cancelled: did not %s", action)
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	default:
+		return fmt.Errorf("cancelled: did not %s", action)
+	}
+}
+
+func isProductionEnvironment(environment string) bool {
+	switch strings.ToLower(strings.TrimSpace(environment)) {
+	case "production", "prod":
+		return true
+	default:
+		return false
+	}
+}
+
+// itemActionVerb names an item update body for confirmation prompts.
+func itemActionVerb(body map[string]any) string {
+	switch body["status"] {
+	case "resolved":
+		return "resolve"
+	case "muted":
+		return "mute"
+	case "active":
+		return "reactivate"
+	}
+	if _, ok := body["snooze_enabled"]; ok {
+		return "snooze"
+	}
+	_, user := body["assigned_user_id"]
+	_, team := body["assigned_team_id"]
+	if user || team {
+		return "assign"
+	}
+	return "update"
+}
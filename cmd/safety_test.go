@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func withInteractiveStdin(t *testing.T, input string) {
+	t.Helper()
+	withStdin(t, input)
+	old := stdinIsInteractive
+	stdinIsInteractive = func() bool { return true }
+	t.Cleanup(func() { stdinIsInteractive = old })
+}
+
+func productionItemServer(t *testing.T, requests *[]string) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.Method+" "+r.URL.Path)
+		_, _ = w.Write([]byte(`{"err":0,"result":{"id":42,"title":"boom","status":"active","environment":"production"}}`))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestItemsResolveDryRunShowsRequestAndCurrentState(t *testing.T) {
+	var requests []string
+	ts := productionItemServer(t, &requests)
+
+	out, err := runCLIWithCapturedStdout(t,
+		"items", "resolve", "42",
+		"--resolved-in-version", "abc123",
+		"--dry-run",
+		"--json",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if strings.Join(requests, ",") != "GET /api/1/item/42" {
+		t.Fatalf("dry run sent unexpected requests: %#v", requests)
+	}
+
+	var plan struct {
+		DryRun  bool           `json:"dry_run"`
+		Method  string         `json:"method"`
+		Path    string         `json:"path"`
+		Body    map[string]any `json:"body"`
+		Current map[string]any `json:"current"`
+	}
+	if err := json.Unmarshal([]byte(out), &plan); err != nil {
+		t.Fatalf("decode plan: %v\n%s", err, out)
+	}
+	if !plan.DryRun || plan.Method != http.MethodPatch || plan.Path != "/api/1/item/42" {
+		t.Fatalf("unexpected plan: %#v", plan)
+	}
+	if plan.Body["status"] != "resolved" || plan.Body["resolved_in_version"] != "abc123" {
+		t.Fatalf("unexpected plan body: %#v", plan.Body)
+	}
+	if plan.Current["status"] != "active" {
+		t.Fatalf("expected current item state, got %#v", plan.Current)
+	}
+}
+
+func TestDeploysCreateDryRunPrintsRequest(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"deploys", "create",
+		"--environment", "production",
+		"--revision", "aabbcc1",
+		"--dry-run",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("expected no API calls, got %d", calls)
+	}
+	if !strings.Contains(out, "Dry run: POST /api/1/deploy") || !strings.Contains(out, `"revision": "aabbcc1"`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestDeploysProductionChangesNeedConfirmation(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		_, _ = w.Write([]byte(`{"err":0,"result":{"deploy":{"id":123,"environment":"production","revision":"aabbcc1","status":"started"}}}`))
+	}))
+	defer ts.Close()
+
+	_, err := runCLIWithCapturedStdout(t,
+		"deploys", "create",
+		"--environment", "production",
+		"--revision", "aabbcc1",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "refusing to record a deploy of aabbcc1 to production without --yes") {
+		t.Fatalf("expected confirmation error, got %v", err)
+	}
+	if len(requests) != 0 {
+		t.Fatalf("expected no API calls, got %#v", requests)
+	}
+
+	_, err = runCLIWithCapturedStdout(t,
+		"deploys", "update", "123",
+		"--status", "succeeded",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "refusing to mark deploy 123 in production as succeeded without --yes") {
+		t.Fatalf("expected confirmation error, got %v", err)
+	}
+	if strings.Join(requests, ",") != "GET /api/1/deploy/123" {
+		t.Fatalf("expected only the pre-fetch, got %#v", requests)
+	}
+
+	requests = nil
+	withInteractiveStdin(t, "y\n")
+	if _, err := runCLIWithCapturedStdout(t,
+		"deploys", "update", "123",
+		"--status", "succeeded",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	); err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if strings.Join(requests, ",") != "GET /api/1/deploy/123,PATCH /api/1/deploy/123" {
+		t.Fatalf("unexpected requests after confirming: %#v", requests)
+	}
+}
+
+func TestItemsResolveProductionNeedsConfirmation(t *testing.T) {
+	var requests []string
+	ts := productionItemServer(t, &requests)
+
+	_, err := runCLIWithCapturedStdout(t,
+		"items", "resolve", "42",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "refusing to resolve item 42 in production without --yes") {
+		t.Fatalf("expected confirmation error, got %v", err)
+	}
+	if strings.Join(requests, ",") != "GET /api/1/item/42" {
+		t.Fatalf("expected only the pre-fetch, got %#v", requests)
+	}
+
+	withInteractiveStdin(t, "n\n")
+	_, err = runCLIWithCapturedStdout(t,
+		"items", "resolve", "42",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "cancelled") {
+		t.Fatalf("expected cancelled error, got %v", err)
+	}
+
+	requests = nil
+	withInteractiveStdin(t, "y\n")
+	_, err = runCLIWithCapturedStdout(t,
+		"items", "resolve", "42",
+		"--json",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if strings.Join(requests, ",") != "GET /api/1/item/42,PATCH /api/1/item/42" {
+		t.Fatalf("unexpected requests: %#v", requests)
+	}
+}
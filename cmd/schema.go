@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+type schemaOptions struct {
+	NDJSON bool
+}
+
+// commandOutputSchema describes a command's structured output with sample
+// values whose types are reflected into JSON Schema. A command that can print
+// more than one shape lists each one.
+type commandOutputSchema struct {
+	JSON    []any
+	NDJSON  []any
+	Mutates bool
+}
+
+var (
+	itemActionSchema = commandOutputSchema{JSON: []any{itemGetJSONOutput{}, bulkItemReport{}}, Mutates: true}
+	membershipSchema = commandOutputSchema{JSON: []any{teamMembershipJSONOutput{}}, NDJSON: []any{teamMembershipJSONOutput{}}, Mutates: true}
+	countsSchema     = commandOutputSchema{JSON: []any{countBucketsJSONOutput{}}, NDJSON: []any{rollbar.CountBucket{}}}
+)
+
+// outputSchemas is keyed by command path without the binary name.
+var outputSchemas = map[string]commandOutputSchema{
+	"items list":                {JSON: []any{itemListJSONOutput{}}, NDJSON: []any{rollbar.Item{}}},
+	"items watch":               {JSON: []any{itemListJSONOutput{}}, NDJSON: []any{rollbar.Item{}, rollbar.WatchEvent{}}},
+	"items get":                 {JSON: []any{itemGetJSONOutput{}}},
+	"items update":              {JSON: []any{itemGetJSONOutput{}}, Mutates: true},
+	"items resolve":             itemActionSchema,
+	"items mute":                itemActionSchema,
+	"items assign":              itemActionSchema,
+	"items snooze":              itemActionSchema,
+	"occurrences list":          {JSON: []any{occurrenceListJSONOutput{}}, NDJSON: []any{rollbar.ItemInstance{}}},
+	"occurrences get":           {JSON: []any{occurrenceGetJSONOutput{}}},
+	"occurrences diff":          {JSON: []any{occurrenceDiffJSONOutput{}}},
+	"occurrences breakdown":     {JSON: []any{occurrenceBreakdownJSONOutput{}}, NDJSON: []any{rollbar.Breakdown{}}},
+	"deploys list":              {JSON: []any{deployListJSONOutput{}}, NDJSON: []any{rollbar.Deploy{}}},
+	"deploys get":               {JSON: []any{deployGetJSONOutput{}}, NDJSON: []any{rollbar.Deploy{}}},
+	"deploys create":            {JSON: []any{deployGetJSONOutput{}}, NDJSON: []any{rollbar.Deploy{}}, Mutates: true},
+	"deploys update":            {JSON: []any{deployGetJSONOutput{}}, NDJSON: []any{rollbar.Deploy{}}, Mutates: true},
+	"environments list":         {JSON: []any{environmentListJSONOutput{}}, NDJSON: []any{rollbar.Environment{}}},
+	"users list":                {JSON: []any{userListJSONOutput{}}, NDJSON: []any{rollbar.User{}}},
+	"users get":                 {JSON: []any{userGetJSONOutput{}}, NDJSON: []any{rollbar.User{}}},
+	"projects list":             {JSON: []any{projectListJSONOutput{}}, NDJSON: []any{rollbar.Project{}}},
+	"projects get":              {JSON: []any{projectGetJSONOutput{}}, NDJSON: []any{rollbar.Project{}}},
+	"projects create":           {JSON: []any{projectGetJSONOutput{}}, NDJSON: []any{rollbar.Project{}}, Mutates: true},
+	"projects delete":           {JSON: []any{projectDeleteJSONOutput{}}, NDJSON: []any{projectDeleteJSONOutput{}}, Mutates: true},
+	"tokens list":               {JSON: []any{tokenListJSONOutput{}}, NDJSON: []any{rollbar.ProjectAccessToken{}}},
+	"tokens create":             {JSON: []any{tokenJSONOutput{}}, NDJSON: []any{rollbar.ProjectAccessToken{}}, Mutates: true},
+	"tokens update":             {JSON: []any{tokenJSONOutput{}}, NDJSON: []any{rollbar.ProjectAccessToken{}}, Mutates: true},
+	"tokens rotate":             {JSON: []any{tokenRotateJSONOutput{}}, NDJSON: []any{tokenRotateJSONOutput{}}, Mutates: true},
+	"teams list":                {JSON: []any{teamListJSONOutput{}}, NDJSON: []any{rollbar.Team{}}},
+	"teams get":                 {JSON: []any{teamGetJSONOutput{}}, NDJSON: []any{rollbar.Team{}}},
+	"teams create":              {JSON: []any{teamGetJSONOutput{}}, NDJSON: []any{rollbar.Team{}}, Mutates: true},
+	"teams delete":              {JSON: []any{teamDeleteJSONOutput{}}, NDJSON: []any{teamDeleteJSONOutput{}}, Mutates: true},
+	"teams users list":          {JSON: []any{userListJSONOutput{}}, NDJSON: []any{rollbar.User{}}},
+	"teams users add":           membershipSchema,
+	"teams users remove":        membershipSchema,
+	"teams projects list":       {JSON: []any{projectListJSONOutput{}}, NDJSON: []any{rollbar.Project{}}},
+	"teams projects add":        membershipSchema,
+	"teams projects remove":     membershipSchema,
+	"reports top-active":        {JSON: []any{topActiveItemsJSONOutput{}}, NDJSON: []any{rollbar.TopActiveItem{}}},
+	"reports occurrence-counts": countsSchema,
+	"reports activated-counts":  countsSchema,
+	"rql run":                   {JSON: []any{rqlResultJSONOutput{}, rqlJobJSONOutput{}}, NDJSON: []any{map[string]any{}, rollbar.RQLJob{}}, Mutates: true},
+	"rql jobs list":             {JSON: []any{rqlJobListJSONOutput{}}, NDJSON: []any{rollbar.RQLJob{}}},
+	"rql jobs get":              {JSON: []any{rqlJobJSONOutput{}, rqlResultJSONOutput{}}, NDJSON: []any{rollbar.RQLJob{}, map[string]any{}}},
+	"rql jobs cancel":           {JSON: []any{rqlJobJSONOutput{}}, NDJSON: []any{rollbar.RQLJob{}}, Mutates: true},
+	"history":                   {JSON: []any{historyJSONOutput{}}, NDJSON: []any{auditEntry{}}},
+	"undo":                      {JSON: []any{itemGetJSONOutput{}}, Mutates: true},
+}
+
+func newSchemaCmd(cfg *cliConfig) *cobra.Command {
+	var opts schemaOptions
+
+	schemaCmd := &cobra.Command{
+		Use:   "schema [command]",
+		Short: "Print the JSON Schema of a command's --json or --ndjson output",
+		Long:  "Print a JSON Schema (draft 2020-12) document describing a command's --json output, or one --ndjson line with --ndjson. Without a command, list the commands that have a schema.",
+		Example: "  rollbar-cli schema items list\n" +
+			"  rollbar-cli schema items list --ndjson",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return writeSchemaIndex()
+			}
+
+			target, _, err := cmd.Root().Find(args)
+			if err != nil || target == cmd.Root() {
+				return fmt.Errorf("unknown command %q", strings.Join(args, " "))
+			}
+			name := strings.TrimPrefix(target.CommandPath(), cmd.Root().Name()+" ")
+			entry, ok := outputSchemas[name]
+			if !ok {
+				return fmt.Errorf("%s has no structured output schema", target.CommandPath())
+			}
+
+			shapes, flag := entry.JSON, "--json"
+			if opts.NDJSON {
+				shapes, flag = entry.NDJSON, "--ndjson"
+				if len(shapes) == 0 {
+					return fmt.Errorf("%s has no --ndjson output", target.CommandPath())
+				}
+			} else if entry.Mutates {
+				shapes = append(slices.Clip(shapes), dryRunJSONOutput{})
+			}
+
+			doc := map[string]any{
+				"$schema": jsonSchemaDialect,
+				"title":   target.CommandPath() + " " + flag,
+			}
+			if len(shapes) == 1 {
+				for key, value := range jsonSchemaFor(reflect.TypeOf(shapes[0])) {
+					doc[key] = value
+				}
+			} else {
+				alternatives := make([]any, 0, len(shapes))
+				for _, shape := range shapes {
+					alternatives = append(alternatives, jsonSchemaFor(reflect.TypeOf(shape)))
+				}
+				doc["anyOf"] = alternatives
+			}
+			return writeJSON(cfg.Filter, doc)
+		},
+	}
+
+	schemaCmd.Flags().BoolVar(&opts.NDJSON, "ndjson", false, "Describe one line of --ndjson output instead of --json")
+
+	return schemaCmd
+}
+
+func writeSchemaIndex() error {
+	names := make([]string, 0, len(outputSchemas))
+	for name := range outputSchemas {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	rows := make([][]string, 0, len(names))
+	for _, name := range names {
+		formats := "json"
+		if len(outputSchemas[name].NDJSON) > 0 {
+			formats += ", ndjson"
+		}
+		rows = append(rows, []string{name, formats})
+	}
+	return renderRows([]string{"COMMAND", "FORMATS"}, rows, true)
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// jsonSchemaFor reflects a Go type into JSON Schema following encoding/json
+// rules: json tags name properties, fields without omitempty are required,
+// and nil slices and maps encode as null.
+func jsonSchemaFor(t reflect.Type) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t {
+	case timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case rawMessageType:
+		return map[string]any{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": []string{"array", "null"}, "items": jsonSchemaFor(t.Elem())}
+	case reflect.Map:
+		schema := map[string]any{"type": []string{"object", "null"}}
+		if t.Elem().Kind() != reflect.Interface {
+			schema["additionalProperties"] = jsonSchemaFor(t.Elem())
+		}
+		return schema
+	case reflect.Struct:
+		properties := make(map[string]any)
+		required := []string{}
+		addStructFields(t, properties, &required)
+		return map[string]any{"type": "object", "properties": properties, "required": required}
+	default:
+		return map[string]any{}
+	}
+}
+
+func addStructFields(t reflect.Type, properties map[string]any, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			addStructFields(field.Type, properties, required)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema := jsonSchemaFor(field.Type)
+		if name == "schema_version" {
+			schema["const"] = jsonSchemaVersion
+		}
+		properties[name] = schema
+		if !slices.Contains(strings.Split(options, ","), "omitempty") {
+			*required = append(*required, name)
+		}
+	}
+}
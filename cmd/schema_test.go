@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestOutputSchemasCoverStructuredOutputCommands(t *testing.T) {
+	root := newRootCmd()
+	seen := make(map[string]bool)
+
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		for _, child := range cmd.Commands() {
+			walk(child)
+		}
+		if cmd.LocalFlags().Lookup("json") == nil {
+			return
+		}
+		name := strings.TrimPrefix(cmd.CommandPath(), root.Name()+" ")
+		seen[name] = true
+		entry, ok := outputSchemas[name]
+		if !ok {
+			t.Errorf("%s has --json output but no schema", name)
+			return
+		}
+		if hasNDJSON := cmd.LocalFlags().Lookup("ndjson") != nil; hasNDJSON != (len(entry.NDJSON) > 0) {
+			t.Errorf("%s: --ndjson flag present=%v but schema lists %d ndjson shapes", name, hasNDJSON, len(entry.NDJSON))
+		}
+	}
+	walk(root)
+
+	for name := range outputSchemas {
+		if !seen[name] {
+			t.Errorf("schema registered for %q, which has no --json flag", name)
+		}
+	}
+}
+
+func TestSchemaCommandItemsList(t *testing.T) {
+	out, err := runCLIWithCapturedStdout(t, "schema", "items", "list")
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+
+	var doc struct {
+		Schema     string   `json:"$schema"`
+		Title      string   `json:"title"`
+		Required   []string `json:"required"`
+		Properties struct {
+			SchemaVersion map[string]any `json:"schema_version"`
+			Items         struct {
+				Items struct {
+					Properties map[string]map[string]any `json:"properties"`
+					Required   []string                  `json:"required"`
+				} `json:"items"`
+			} `json:"items"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal([]byte(out), &doc); err != nil {
+		t.Fatalf("decode schema: %v\n%s", err, out)
+	}
+	if doc.Schema != jsonSchemaDialect || doc.Title != "rollbar-cli items list --json" {
+		t.Fatalf("unexpected schema header: %q %q", doc.Schema, doc.Title)
+	}
+	if strings.Join(doc.Required, ",") != "schema_version,items" || doc.Properties.SchemaVersion["const"] != float64(jsonSchemaVersion) {
+		t.Fatalf("unexpected envelope schema: %s", out)
+	}
+	item := doc.Properties.Items.Items
+	if item.Properties["last_occurrence_timestamp"]["type"] != "integer" || item.Properties["snooze_enabled"]["type"] != "boolean" {
+		t.Fatalf("unexpected item properties: %#v", item.Properties)
+	}
+	if !strings.Contains(strings.Join(item.Required, ","), "id,counter,title") || strings.Contains(strings.Join(item.Required, ","), "hash") {
+		t.Fatalf("unexpected required item fields: %#v", item.Required)
+	}
+}
+
+func TestSchemaCommandVariants(t *testing.T) {
+	out, err := runCLIWithCapturedStdout(t, "schema", "items", "list", "--ndjson")
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if !strings.Contains(out, `"title": "rollbar-cli items list --ndjson"`) || strings.Contains(out, "schema_version") {
+		t.Fatalf("unexpected ndjson schema: %s", out)
+	}
+
+	out, err = runCLIWithCapturedStdout(t, "schema", "items", "resolve")
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	var doc struct {
+		AnyOf []map[string]any `json:"anyOf"`
+	}
+	if err := json.Unmarshal([]byte(out), &doc); err != nil || len(doc.AnyOf) != 3 {
+		t.Fatalf("expected item, bulk, and dry-run shapes: %v\n%s", err, out)
+	}
+
+	if _, err := runCLIWithCapturedStdout(t, "schema", "items", "get", "--ndjson"); err == nil || !strings.Contains(err.Error(), "has no --ndjson output") {
+		t.Fatalf("expected no-ndjson error, got %v", err)
+	}
+	if _, err := runCLIWithCapturedStdout(t, "schema", "completion"); err == nil || !strings.Contains(err.Error(), "no structured output schema") {
+		t.Fatalf("expected no-schema error, got %v", err)
+	}
+
+	out, err = runCLIWithCapturedStdout(t, "schema")
+	if err != nil || !strings.Contains(out, "items list") || !strings.Contains(out, "json, ndjson") {
+		t.Fatalf("unexpected schema index: %v\n%s", err, out)
+	}
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/davebarnwell/rollbar-cli/internal/source"
+	"github.com/davebarnwell/rollbar-cli/internal/ui"
+)
+
+const defaultSourceContext = 3
+
+// sourceOptions maps stack frames onto a local checkout for text output.
+type sourceOptions struct {
+	Root         string
+	PathRewrites []string
+	Context      int
+	Blame        bool
+	Color        string
+}
+
+func addSourceFlags(flags *pflag.FlagSet, opts *sourceOptions) {
+	flags.StringVar(&opts.Root, "source-root", "", "Local checkout to show stack-frame source from in text output")
+	flags.StringArrayVar(&opts.PathRewrites, "path-rewrite", nil, "Rewrite a frame path prefix before looking it up, as FROM=TO (e.g. /app/=./); repeatable, tried before the profile's path_rewrites")
+	flags.IntVar(&opts.Context, "context", defaultSourceContext, "Lines of source to show around each frame's line")
+	flags.BoolVar(&opts.Blame, "blame", false, "Annotate each frame's line with git blame author and commit")
+	flags.StringVar(&opts.Color, "color", "auto", "Highlight source in text output: auto|always|never")
+}
+
+// renderOptions returns the zero value, which renders frames on one line,
+// unless --source-root was given.
+func (o sourceOptions) renderOptions(cfg *cliConfig) (ui.SourceRenderOptions, error) {
+	if o.Root == "" {
+		if o.Blame {
+			return ui.SourceRenderOptions{}, fmt.Errorf("--blame needs --source-root")
+		}
+		return ui.SourceRenderOptions{}, nil
+	}
+	if o.Context < 0 {
+		return ui.SourceRenderOptions{}, fmt.Errorf("--context must be >= 0")
+	}
+	color, err := resolveColor(o.Color)
+	if err != nil {
+		return ui.SourceRenderOptions{}, err
+	}
+
+	rewrites := make([]source.Rewrite, 0, len(o.PathRewrites)+len(cfg.PathRewrites))
+	for _, spec := range append(append([]string{}, o.PathRewrites...), cfg.PathRewrites...) {
+		rewrite, err := source.ParseRewrite(spec)
+		if err != nil {
+			return ui.SourceRenderOptions{}, err
+		}
+		rewrites = append(rewrites, rewrite)
+	}
+	resolver := source.NewResolver(source.Options{Root: o.Root, Rewrites: rewrites, Context: o.Context, Blame: o.Blame})
+	return ui.SourceRenderOptions{Resolver: resolver, Color: color}, nil
+}
@@ -0,0 +1,528 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+	"github.com/davebarnwell/rollbar-cli/internal/ui"
+)
+
+type teamsListOptions struct {
+	Output    string
+	JSON      bool
+	RawJSON   bool
+	NDJSON    bool
+	Fields    []string
+	NoHeaders bool
+}
+
+type teamsGetOptions struct {
+	Output  string
+	JSON    bool
+	RawJSON bool
+	NDJSON  bool
+	Fields  []string
+}
+
+type teamsCreateOptions struct {
+	Name        string
+	AccessLevel string
+	Output      string
+	JSON        bool
+	RawJSON     bool
+	NDJSON      bool
+}
+
+type teamsDeleteOptions struct {
+	Output  string
+	JSON    bool
+	RawJSON bool
+	NDJSON  bool
+}
+
+type teamsMembershipOptions struct {
+	Output  string
+	JSON    bool
+	RawJSON bool
+	NDJSON  bool
+}
+
+type teamListJSONOutput struct {
+	SchemaVersion int            `json:"schema_version"`
+	Teams         []rollbar.Team `json:"teams"`
+}
+
+type teamGetJSONOutput struct {
+	Team rollbar.Team `json:"team"`
+}
+
+type teamDeleteJSONOutput struct {
+	Deleted bool  `json:"deleted"`
+	TeamID  int64 `json:"team_id"`
+}
+
+type teamMembershipJSONOutput struct {
+	TeamID    int64 `json:"team_id"`
+	UserID    int64 `json:"user_id,omitempty"`
+	ProjectID int64 `json:"project_id,omitempty"`
+	Member    bool  `json:"member"`
+}
+
+// teamMembershipChange describes one add/remove subcommand so the four
+// user/project variants can share a single implementation.
+type teamMembershipChange struct {
+	Kind  string
+	Add   bool
+	Apply func(client *rollbar.Client, ctx context.Context, teamID int64, memberID int64) (map[string]any, error)
+}
+
+func newTeamsCmd(cfg *cliConfig) *cobra.Command {
+	var (
+		listOpts         teamsListOptions
+		getOpts          teamsGetOptions
+		createOpts       teamsCreateOptions
+		deleteOpts       teamsDeleteOptions
+		usersListOpts    teamsListOptions
+		projectsListOpts teamsListOptions
+	)
+
+	teamsCmd := &cobra.Command{
+		Use:     "teams",
+		Aliases: []string{"team"},
+		Short:   "Manage Rollbar teams (requires an account token)",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List teams in the Rollbar account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireAccountToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, listOpts.Output, listOpts.JSON, listOpts.RawJSON, listOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			resp, err := client.ListTeams(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			switch output {
+			case outputRawJSON:
+				return writeJSON(cfg.Filter, resp.Raw)
+			case outputJSON:
+				return writeJSON(cfg.Filter, teamListJSONOutput{SchemaVersion: jsonSchemaVersion, Teams: resp.Teams})
+			case outputNDJSON:
+				records := make([]any, 0, len(resp.Teams))
+				for _, team := range resp.Teams {
+					records = append(records, team)
+				}
+				return writeNDJSON(cfg.Filter, records)
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.TeamsTable(resp.Teams, normalizeFields(listOpts.Fields)), listOpts.NoHeaders)
+			default:
+				return ui.RenderTeamsWithOptions(resp.Teams, ui.TeamRenderOptions{
+					Fields:    normalizeFields(listOpts.Fields),
+					NoHeaders: listOpts.NoHeaders,
+				})
+			}
+		},
+	}
+
+	getCmd := &cobra.Command{
+		Use:   "get <team>",
+		Short: "Get a team by ID or name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireAccountToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, getOpts.Output, getOpts.JSON, getOpts.RawJSON, getOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			id, err := resolveTeamRef(cmd.Context(), client, args[0])
+			if err != nil {
+				return err
+			}
+			resp, err := client.GetTeam(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+			return writeSingleTeamOutput(cfg.Filter, resp.Team, resp.Raw, output, normalizeFields(getOpts.Fields))
+		},
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a team",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireAccountToken(cfg); err != nil {
+				return err
+			}
+
+			name := strings.TrimSpace(createOpts.Name)
+			if name == "" {
+				return fmt.Errorf("missing required flag: --name")
+			}
+			accessLevel := strings.TrimSpace(strings.ToLower(createOpts.AccessLevel))
+			if !slices.Contains(rollbar.TeamAccessLevels, accessLevel) {
+				return fmt.Errorf("invalid --access-level %q: expected %s", createOpts.AccessLevel, strings.Join(rollbar.TeamAccessLevels, "|"))
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, createOpts.Output, createOpts.JSON, createOpts.RawJSON, createOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			resp, err := client.CreateTeam(cmd.Context(), name, accessLevel)
+			if err != nil {
+				return err
+			}
+			return writeSingleTeamOutput(cfg.Filter, resp.Team, resp.Raw, output, nil)
+		},
+	}
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <team>",
+		Short: "Delete a team",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireAccountToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, deleteOpts.Output, deleteOpts.JSON, deleteOpts.RawJSON, deleteOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			id, err := resolveTeamRef(cmd.Context(), client, args[0])
+			if err != nil {
+				return err
+			}
+			if err := confirmAction(cmd, cfg, fmt.Sprintf("delete team %d", id)); err != nil {
+				return err
+			}
+
+			raw, err := client.DeleteTeam(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			switch output {
+			case outputRawJSON:
+				return writeJSON(cfg.Filter, raw)
+			case outputJSON:
+				return writeJSON(cfg.Filter, teamDeleteJSONOutput{Deleted: true, TeamID: id})
+			case outputNDJSON:
+				return writeNDJSON(cfg.Filter, []any{teamDeleteJSONOutput{Deleted: true, TeamID: id}})
+			default:
+				return writeStdoutf("Deleted team %d\n", id)
+			}
+		},
+	}
+
+	usersCmd := &cobra.Command{
+		Use:   "users",
+		Short: "List and manage the users on a team",
+	}
+
+	usersListCmd := &cobra.Command{
+		Use:   "list <team>",
+		Short: "List the users on a team",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireAccountToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, usersListOpts.Output, usersListOpts.JSON, usersListOpts.RawJSON, usersListOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			id, err := resolveTeamRef(cmd.Context(), client, args[0])
+			if err != nil {
+				return err
+			}
+			resp, err := client.ListTeamUsers(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			switch output {
+			case outputRawJSON:
+				return writeJSON(cfg.Filter, resp.Raw)
+			case outputJSON:
+				return writeJSON(cfg.Filter, userListJSONOutput{SchemaVersion: jsonSchemaVersion, Users: resp.Users})
+			case outputNDJSON:
+				records := make([]any, 0, len(resp.Users))
+				for _, user := range resp.Users {
+					records = append(records, user)
+				}
+				return writeNDJSON(cfg.Filter, records)
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.UsersTable(resp.Users, normalizeFields(usersListOpts.Fields)), usersListOpts.NoHeaders)
+			default:
+				return ui.RenderUsersWithOptions(resp.Users, ui.UserRenderOptions{
+					Fields:    normalizeFields(usersListOpts.Fields),
+					NoHeaders: usersListOpts.NoHeaders,
+				})
+			}
+		},
+	}
+
+	projectsCmd := &cobra.Command{
+		Use:   "projects",
+		Short: "List and manage the projects a team can access",
+	}
+
+	projectsListCmd := &cobra.Command{
+		Use:   "list <team>",
+		Short: "List the projects a team can access",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireAccountToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, projectsListOpts.Output, projectsListOpts.JSON, projectsListOpts.RawJSON, projectsListOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			id, err := resolveTeamRef(cmd.Context(), client, args[0])
+			if err != nil {
+				return err
+			}
+			resp, err := client.ListTeamProjects(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			switch output {
+			case outputRawJSON:
+				return writeJSON(cfg.Filter, resp.Raw)
+			case outputJSON:
+				return writeJSON(cfg.Filter, projectListJSONOutput{SchemaVersion: jsonSchemaVersion, Projects: resp.Projects})
+			case outputNDJSON:
+				records := make([]any, 0, len(resp.Projects))
+				for _, project := range resp.Projects {
+					records = append(records, project)
+				}
+				return writeNDJSON(cfg.Filter, records)
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.ProjectsTable(resp.Projects, normalizeFields(projectsListOpts.Fields)), projectsListOpts.NoHeaders)
+			default:
+				return ui.RenderProjectsWithOptions(resp.Projects, ui.ProjectRenderOptions{
+					Fields:    normalizeFields(projectsListOpts.Fields),
+					NoHeaders: projectsListOpts.NoHeaders,
+				})
+			}
+		},
+	}
+
+	addTeamsListFlags(listCmd, &listOpts)
+	addTeamsListFlags(usersListCmd, &usersListOpts)
+	addTeamsListFlags(projectsListCmd, &projectsListOpts)
+
+	getCmd.Flags().StringVarP(&getOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
+	getCmd.Flags().BoolVar(&getOpts.JSON, "json", false, "Shortcut for --output json")
+	getCmd.Flags().BoolVar(&getOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	getCmd.Flags().BoolVar(&getOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	getCmd.Flags().StringSliceVar(&getOpts.Fields, "fields", nil, "Fields to render in csv, tsv, yaml, and markdown output")
+
+	createCmd.Flags().StringVar(&createOpts.Name, "name", "", "Team name")
+	createCmd.Flags().StringVar(&createOpts.AccessLevel, "access-level", "standard", "Access level: "+strings.Join(rollbar.TeamAccessLevels, "|"))
+	createCmd.Flags().StringVarP(&createOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|template")
+	createCmd.Flags().BoolVar(&createOpts.JSON, "json", false, "Shortcut for --output json")
+	createCmd.Flags().BoolVar(&createOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	createCmd.Flags().BoolVar(&createOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+
+	deleteCmd.Flags().StringVarP(&deleteOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|template")
+	deleteCmd.Flags().BoolVar(&deleteOpts.JSON, "json", false, "Shortcut for --output json")
+	deleteCmd.Flags().BoolVar(&deleteOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	deleteCmd.Flags().BoolVar(&deleteOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+
+	usersCmd.AddCommand(
+		usersListCmd,
+		newTeamMembershipCmd(cfg, "add <team> <user-id>", "Add a user to a team", teamMembershipChange{Kind: "user", Add: true, Apply: (*rollbar.Client).AddTeamUser}),
+		newTeamMembershipCmd(cfg, "remove <team> <user-id>", "Remove a user from a team", teamMembershipChange{Kind: "user", Apply: (*rollbar.Client).RemoveTeamUser}),
+	)
+	projectsCmd.AddCommand(
+		projectsListCmd,
+		newTeamMembershipCmd(cfg, "add <team> <project-id>", "Give a team access to a project", teamMembershipChange{Kind: "project", Add: true, Apply: (*rollbar.Client).AddTeamProject}),
+		newTeamMembershipCmd(cfg, "remove <team> <project-id>", "Remove a team's access to a project", teamMembershipChange{Kind: "project", Apply: (*rollbar.Client).RemoveTeamProject}),
+	)
+	teamsCmd.AddCommand(listCmd, getCmd, createCmd, deleteCmd, usersCmd, projectsCmd)
+	return teamsCmd
+}
+
+func addTeamsListFlags(cmd *cobra.Command, opts *teamsListOptions) {
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Shortcut for --output json")
+	cmd.Flags().BoolVar(&opts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	cmd.Flags().BoolVar(&opts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	cmd.Flags().StringSliceVar(&opts.Fields, "fields", nil, "Fields to render in text, csv, tsv, yaml, and markdown output")
+	cmd.Flags().BoolVar(&opts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
+}
+
+func newTeamMembershipCmd(cfg *cliConfig, use string, short string, change teamMembershipChange) *cobra.Command {
+	var opts teamsMembershipOptions
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireAccountToken(cfg); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, opts.Output, opts.JSON, opts.RawJSON, opts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			if err != nil {
+				return err
+			}
+
+			memberID, err := strconv.ParseInt(strings.TrimSpace(args[1]), 10, 64)
+			if err != nil || memberID <= 0 {
+				return fmt.Errorf("invalid %s id %q: must be > 0", change.Kind, args[1])
+			}
+
+			client := newRollbarAccountClient(cfg)
+			teamID, err := resolveTeamRef(cmd.Context(), client, args[0])
+			if err != nil {
+				return err
+			}
+			raw, err := change.Apply(client, cmd.Context(), teamID, memberID)
+			if err != nil {
+				return err
+			}
+
+			out := teamMembershipJSONOutput{TeamID: teamID, Member: change.Add}
+			if change.Kind == "user" {
+				out.UserID = memberID
+			} else {
+				out.ProjectID = memberID
+			}
+
+			switch output {
+			case outputRawJSON:
+				return writeJSON(cfg.Filter, raw)
+			case outputJSON:
+				return writeJSON(cfg.Filter, out)
+			case outputNDJSON:
+				return writeNDJSON(cfg.Filter, []any{out})
+			default:
+				if change.Add {
+					return writeStdoutf("Added %s %d to team %d\n", change.Kind, memberID, teamID)
+				}
+				return writeStdoutf("Removed %s %d from team %d\n", change.Kind, memberID, teamID)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|template")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Shortcut for --output json")
+	cmd.Flags().BoolVar(&opts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	cmd.Flags().BoolVar(&opts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	return cmd
+}
+
+func writeSingleTeamOutput(filter outputFilter, team rollbar.Team, raw map[string]any, output string, fields []string) error {
+	switch output {
+	case outputRawJSON:
+		return writeJSON(filter, raw)
+	case outputJSON:
+		return writeJSON(filter, teamGetJSONOutput{Team: team})
+	case outputNDJSON:
+		return writeNDJSON(filter, []any{team})
+	case outputCSV, outputTSV, outputYAML, outputMarkdown:
+		return writeTable(output, ui.TeamsTable([]rollbar.Team{team}, fields), false)
+	default:
+		return ui.RenderTeam(team)
+	}
+}
+
+// resolveTeamRef accepts a numeric team ID as-is and otherwise looks the name
+// up through the teams API.
+func resolveTeamRef(ctx context.Context, client *rollbar.Client, ref string) (int64, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return 0, fmt.Errorf("missing team: pass a team ID or name")
+	}
+	if isIntegerToken(ref) {
+		id, err := strconv.ParseInt(ref, 10, 64)
+		if err != nil || id <= 0 {
+			return 0, fmt.Errorf("invalid team id %q: must be > 0", ref)
+		}
+		return id, nil
+	}
+
+	resp, err := client.ListTeams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	team, err := findTeamByName(resp.Teams, ref)
+	if err != nil {
+		return 0, err
+	}
+	return team.ID, nil
+}
+
+func findTeamByName(teams []rollbar.Team, name string) (rollbar.Team, error) {
+	var matches []rollbar.Team
+	for _, team := range teams {
+		if strings.EqualFold(strings.TrimSpace(team.Name), name) {
+			matches = append(matches, team)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return rollbar.Team{}, fmt.Errorf("no team named %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return rollbar.Team{}, fmt.Errorf("%d teams are named %q: pass the team ID instead", len(matches), name)
+	}
+}
+
+// resolveTeamFlag resolves --team for item assignment commands. The teams API
+// needs an account-level token, which falls back to the project token.
+func resolveTeamFlag(cmd *cobra.Command, cfg *cliConfig, ref string) (int64, error) {
+	if err := requireAccountToken(cfg); err != nil {
+		return 0, err
+	}
+	return resolveTeamRef(cmd.Context(), newRollbarAccountClient(cfg), ref)
+}
+
+func validateTeamFlag(cmd *cobra.Command, clearAssignedTeam bool) error {
+	if !cmd.Flags().Changed("team") {
+		return nil
+	}
+	if cmd.Flags().Changed("assigned-team-id") {
+		return fmt.Errorf("use either --team or --assigned-team-id, not both")
+	}
+	if clearAssignedTeam {
+		return fmt.Errorf("use either --team or --clear-assigned-team, not both")
+	}
+	return nil
+}
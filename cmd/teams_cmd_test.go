@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTeamsListCommandUsesAccountToken(t *testing.T) {
+	var gotToken string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotToken = r.Header.Get("X-Rollbar-Access-Token")
+		_, _ = w.Write([]byte(`{"err":0,"result":[{"id":7,"account_id":3,"name":"Backend","access_level":"standard"}]}`))
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"teams", "list",
+		"--token", "project-tok",
+		"--account-token", "account-tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if gotToken != "account-tok" {
+		t.Fatalf("expected account token header, got %q", gotToken)
+	}
+	if !strings.Contains(out, "ACCESS_LEVEL") || !strings.Contains(out, "Backend") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestTeamsUsersAddResolvesTeamName(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.URL.Path == "/api/1/teams" {
+			_, _ = w.Write([]byte(`{"err":0,"result":[{"id":7,"name":"Backend"},{"id":9,"name":"Frontend"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"err":0,"result":null}`))
+	}))
+	defer ts.Close()
+
+	out, err := runCLIWithCapturedStdout(t,
+		"teams", "users", "add", "frontend", "21",
+		"--json",
+		"--account-token", "account-tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if len(requests) != 2 || requests[1] != "PUT /api/1/team/9/user/21" {
+		t.Fatalf("unexpected requests: %#v", requests)
+	}
+	if !strings.Contains(out, "\"team_id\": 9") || !strings.Contains(out, "\"member\": true") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestTeamsDeleteRequiresYes(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"err":0,"result":null}`))
+	}))
+	defer ts.Close()
+
+	_, err := runCLIWithCapturedStdout(t,
+		"teams", "delete", "7",
+		"--account-token", "account-tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "without --yes") {
+		t.Fatalf("expected confirmation error, got %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("expected no API calls, got %d", calls)
+	}
+}
+
+func TestItemsAssignTeamFlagResolvesName(t *testing.T) {
+	var tokens []string
+	var body map[string]any
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("X-Rollbar-Access-Token"))
+		switch r.URL.Path {
+		case "/api/1/teams":
+			_, _ = w.Write([]byte(`{"err":0,"result":[{"id":7,"name":"Backend"}]}`))
+		case "/api/1/item/42":
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":42,"title":"boom"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	_, err := runCLIWithCapturedStdout(t,
+		"items", "assign", "42",
+		"--team", "backend",
+		"--json",
+		"--token", "project-tok",
+		"--account-token", "account-tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+	if body["assigned_team_id"] != float64(7) {
+		t.Fatalf("unexpected update body: %#v", body)
+	}
+	if len(tokens) != 3 || tokens[0] != "account-tok" || tokens[1] != "project-tok" || tokens[2] != "project-tok" {
+		t.Fatalf("unexpected tokens: %#v", tokens)
+	}
+}
+
+func TestItemsTeamFlagErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"err":0,"result":[{"id":7,"name":"Backend"},{"id":8,"name":"backend"}]}`))
+	}))
+	defer ts.Close()
+
+	_, err := runCLIWithCapturedStdout(t,
+		"items", "update", "42",
+		"--team", "backend",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "2 teams are named") {
+		t.Fatalf("expected ambiguity error, got %v", err)
+	}
+
+	_, err = runCLIWithCapturedStdout(t,
+		"items", "assign", "42",
+		"--team", "backend",
+		"--clear-assigned-team",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "--team or --clear-assigned-team") {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+}
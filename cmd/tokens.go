@@ -0,0 +1,476 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+	"github.com/davebarnwell/rollbar-cli/internal/ui"
+)
+
+var validTokenStatuses = map[string]struct{}{
+	"enabled":  {},
+	"disabled": {},
+}
+
+type tokensListOptions struct {
+	Output     string
+	JSON       bool
+	RawJSON    bool
+	NDJSON     bool
+	Fields     []string
+	NoHeaders  bool
+	ShowTokens bool
+}
+
+type tokensCreateOptions struct {
+	Name                 string
+	Scopes               []string
+	Status               string
+	RateLimitWindowSize  int64
+	RateLimitWindowCount int64
+	Output               string
+	JSON                 bool
+	RawJSON              bool
+	NDJSON               bool
+}
+
+type tokensUpdateOptions struct {
+	Status               string
+	RateLimitWindowSize  int64
+	RateLimitWindowCount int64
+	Output               string
+	JSON                 bool
+	RawJSON              bool
+	NDJSON               bool
+}
+
+type tokensRotateOptions struct {
+	Name         string
+	WriteProfile bool
+	KeepOld      bool
+	Output       string
+	JSON         bool
+	RawJSON      bool
+	NDJSON       bool
+}
+
+type tokenListJSONOutput struct {
+	SchemaVersion int                          `json:"schema_version"`
+	Tokens        []rollbar.ProjectAccessToken `json:"tokens"`
+}
+
+type tokenJSONOutput struct {
+	Token rollbar.ProjectAccessToken `json:"token"`
+}
+
+type tokenRotateJSONOutput struct {
+	NewToken       rollbar.ProjectAccessToken `json:"new_token"`
+	OldToken       rollbar.ProjectAccessToken `json:"old_token"`
+	OldDisabled    bool                       `json:"old_disabled"`
+	ProfileUpdated string                     `json:"profile_updated,omitempty"`
+}
+
+func newTokensCmd(cfg *cliConfig) *cobra.Command {
+	var (
+		projectID  int64
+		listOpts   tokensListOptions
+		createOpts tokensCreateOptions
+		updateOpts tokensUpdateOptions
+		rotateOpts tokensRotateOptions
+	)
+
+	tokensCmd := &cobra.Command{
+		Use:     "tokens",
+		Aliases: []string{"token"},
+		Short:   "Manage project access tokens (requires an account token)",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List access tokens for a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireTokensProject(cfg, projectID); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, listOpts.Output, listOpts.JSON, listOpts.RawJSON, listOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			resp, err := client.ListProjectAccessTokens(cmd.Context(), projectID)
+			if err != nil {
+				return err
+			}
+
+			switch output {
+			case outputRawJSON:
+				return writeJSON(cfg.Filter, resp.Raw)
+			case outputJSON:
+				return writeJSON(cfg.Filter, tokenListJSONOutput{SchemaVersion: jsonSchemaVersion, Tokens: resp.Tokens})
+			case outputNDJSON:
+				records := make([]any, 0, len(resp.Tokens))
+				for _, token := range resp.Tokens {
+					records = append(records, token)
+				}
+				return writeNDJSON(cfg.Filter, records)
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.ProjectTokensTable(resp.Tokens, normalizeFields(listOpts.Fields), listOpts.ShowTokens), listOpts.NoHeaders)
+			default:
+				return ui.RenderProjectTokensWithOptions(resp.Tokens, ui.ProjectTokenRenderOptions{
+					Fields:     normalizeFields(listOpts.Fields),
+					NoHeaders:  listOpts.NoHeaders,
+					ShowTokens: listOpts.ShowTokens,
+				})
+			}
+		},
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a project access token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireTokensProject(cfg, projectID); err != nil {
+				return err
+			}
+
+			body, err := buildTokenCreateBody(cmd, createOpts)
+			if err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, createOpts.Output, createOpts.JSON, createOpts.RawJSON, createOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			resp, err := client.CreateProjectAccessToken(cmd.Context(), projectID, body)
+			if err != nil {
+				return err
+			}
+			return writeSingleTokenOutput(cfg.Filter, resp.Token, resp.Raw, output)
+		},
+	}
+
+	updateCmd := &cobra.Command{
+		Use:   "update <access-token>",
+		Short: "Update a project access token's status or rate limit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireTokensProject(cfg, projectID); err != nil {
+				return err
+			}
+
+			body, err := buildTokenUpdateBody(cmd, updateOpts)
+			if err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, updateOpts.Output, updateOpts.JSON, updateOpts.RawJSON, updateOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			if err != nil {
+				return err
+			}
+
+			client := newRollbarAccountClient(cfg)
+			resp, err := client.UpdateProjectAccessToken(cmd.Context(), projectID, args[0], body)
+			if err != nil {
+				return err
+			}
+			return writeSingleTokenOutput(cfg.Filter, resp.Token, resp.Raw, output)
+		},
+	}
+
+	rotateCmd := &cobra.Command{
+		Use:   "rotate <access-token-or-name>",
+		Short: "Replace a project access token with a new one and disable the old one",
+		Long: "Creates a new token with the same scopes and rate limit as the existing one, optionally writes it " +
+			"into the selected config profile, and then disables the old token.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireTokensProject(cfg, projectID); err != nil {
+				return err
+			}
+
+			output, err := resolveOutputModeWithAliases(cfg.Filter, rotateOpts.Output, rotateOpts.JSON, rotateOpts.RawJSON, rotateOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			if err != nil {
+				return err
+			}
+
+			var profile *fileProfile
+			if rotateOpts.WriteProfile {
+				// Fail before creating anything if there is nowhere to write the new token.
+				if profile, err = loadSelectedProfile(cfg); err != nil {
+					return err
+				}
+				if profile == nil {
+					return fmt.Errorf("--write-profile needs a config profile: pass --config and --profile")
+				}
+			}
+
+			client := newRollbarAccountClient(cfg)
+			list, err := client.ListProjectAccessTokens(cmd.Context(), projectID)
+			if err != nil {
+				return err
+			}
+			old, err := findProjectToken(list.Tokens, args[0])
+			if err != nil {
+				return err
+			}
+			if profile != nil && strings.TrimSpace(profile.Token) != old.AccessToken {
+				return fmt.Errorf("--write-profile: the selected profile does not hold token %s; nothing was rotated", ui.MaskToken(old.AccessToken))
+			}
+
+			created, err := client.CreateProjectAccessToken(cmd.Context(), projectID, rotatedTokenBody(old, rotateOpts.Name))
+			if err != nil {
+				return fmt.Errorf("create replacement token: %w", err)
+			}
+			if created.Token.AccessToken == "" {
+				return fmt.Errorf("rollbar did not return the replacement token; the old token was left enabled")
+			}
+
+			result := tokenRotateJSONOutput{NewToken: created.Token, OldToken: old}
+			if rotateOpts.WriteProfile {
+				path, err := updateSelectedProfile(cfg, func(profile *jsonObject) error {
+					if strings.TrimSpace(profile.getString("token")) != old.AccessToken {
+						return fmt.Errorf("the profile token changed since the rotation started")
+					}
+					return profile.set("token", created.Token.AccessToken)
+				})
+				if err != nil {
+					return fmt.Errorf("created replacement token %s but could not update the profile (old token left enabled): %w", created.Token.AccessToken, err)
+				}
+				result.ProfileUpdated = path
+			}
+
+			if !rotateOpts.KeepOld {
+				if _, err := client.UpdateProjectAccessToken(cmd.Context(), projectID, old.AccessToken, map[string]any{"status": "disabled"}); err != nil {
+					return fmt.Errorf("created replacement token %s but could not disable the old token: %w", created.Token.AccessToken, err)
+				}
+				result.OldToken.Status = "disabled"
+				result.OldDisabled = true
+			}
+
+			switch output {
+			case outputRawJSON:
+				return writeJSON(cfg.Filter, created.Raw)
+			case outputJSON:
+				return writeJSON(cfg.Filter, result)
+			case outputNDJSON:
+				return writeNDJSON(cfg.Filter, []any{result})
+			default:
+				if err := ui.RenderProjectToken(result.NewToken); err != nil {
+					return err
+				}
+				if result.ProfileUpdated != "" {
+					if err := writeStdoutf("Updated profile token in %s\n", result.ProfileUpdated); err != nil {
+						return err
+					}
+				}
+				if result.OldDisabled {
+					return writeStdoutf("Disabled old token %s\n", ui.MaskToken(old.AccessToken))
+				}
+				return nil
+			}
+		},
+	}
+
+	tokensCmd.PersistentFlags().Int64Var(&projectID, "project", 0, "Project ID")
+
+	listCmd.Flags().BoolVar(&listOpts.ShowTokens, "show-tokens", false, "Print full token values in text and table output")
+	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
+	listCmd.Flags().BoolVar(&listOpts.JSON, "json", false, "Shortcut for --output json")
+	listCmd.Flags().BoolVar(&listOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	listCmd.Flags().BoolVar(&listOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text, csv, tsv, yaml, and markdown output")
+	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
+
+	createCmd.Flags().StringVar(&createOpts.Name, "name", "", "Token name")
+	createCmd.Flags().StringSliceVar(&createOpts.Scopes, "scope", nil, "Token scope: read|write|post_server_item|post_client_item (repeatable)")
+	createCmd.Flags().StringVar(&createOpts.Status, "status", "", "Token status: enabled|disabled")
+	addTokenRateLimitFlags(createCmd, &createOpts.RateLimitWindowSize, &createOpts.RateLimitWindowCount)
+	createCmd.Flags().StringVarP(&createOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|template")
+	createCmd.Flags().BoolVar(&createOpts.JSON, "json", false, "Shortcut for --output json")
+	createCmd.Flags().BoolVar(&createOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	createCmd.Flags().BoolVar(&createOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+
+	updateCmd.Flags().StringVar(&updateOpts.Status, "status", "", "Token status: enabled|disabled")
+	addTokenRateLimitFlags(updateCmd, &updateOpts.RateLimitWindowSize, &updateOpts.RateLimitWindowCount)
+	updateCmd.Flags().StringVarP(&updateOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|template")
+	updateCmd.Flags().BoolVar(&updateOpts.JSON, "json", false, "Shortcut for --output json")
+	updateCmd.Flags().BoolVar(&updateOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	updateCmd.Flags().BoolVar(&updateOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+
+	rotateCmd.Flags().StringVar(&rotateOpts.Name, "name", "", "Name for the replacement token (default: the old token's name)")
+	rotateCmd.Flags().BoolVar(&rotateOpts.WriteProfile, "write-profile", false, "Write the new token into the selected config profile; the profile must hold the token being rotated")
+	rotateCmd.Flags().BoolVar(&rotateOpts.KeepOld, "keep-old", false, "Leave the old token enabled")
+	rotateCmd.Flags().StringVarP(&rotateOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|template")
+	rotateCmd.Flags().BoolVar(&rotateOpts.JSON, "json", false, "Shortcut for --output json")
+	rotateCmd.Flags().BoolVar(&rotateOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
+	rotateCmd.Flags().BoolVar(&rotateOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+
+	tokensCmd.AddCommand(listCmd, createCmd, updateCmd, rotateCmd)
+	return tokensCmd
+}
+
+func addTokenRateLimitFlags(cmd *cobra.Command, windowSize *int64, windowCount *int64) {
+	cmd.Flags().Int64Var(windowSize, "rate-limit-window-size", 0, "Rate limit window in seconds")
+	cmd.Flags().Int64Var(windowCount, "rate-limit-window-count", 0, "Maximum calls per rate limit window (0 for unlimited)")
+}
+
+func requireTokensProject(cfg *cliConfig, projectID int64) error {
+	if err := requireAccountToken(cfg); err != nil {
+		return err
+	}
+	if projectID <= 0 {
+		return fmt.Errorf("missing required flag: --project")
+	}
+	return nil
+}
+
+func buildTokenCreateBody(cmd *cobra.Command, opts tokensCreateOptions) (map[string]any, error) {
+	name := strings.TrimSpace(opts.Name)
+	if name == "" {
+		return nil, fmt.Errorf("missing required flag: --name")
+	}
+	scopes, err := normalizeTokenScopes(opts.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("missing required flag: --scope")
+	}
+
+	body := map[string]any{
+		"name":   name,
+		"scopes": scopes,
+	}
+	if status, err := normalizeTokenStatus(opts.Status); err != nil {
+		return nil, err
+	} else if status != "" {
+		body["status"] = status
+	}
+	if err := applyTokenRateLimit(cmd, body, opts.RateLimitWindowSize, opts.RateLimitWindowCount); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func buildTokenUpdateBody(cmd *cobra.Command, opts tokensUpdateOptions) (map[string]any, error) {
+	body := map[string]any{}
+	if status, err := normalizeTokenStatus(opts.Status); err != nil {
+		return nil, err
+	} else if status != "" {
+		body["status"] = status
+	}
+	if err := applyTokenRateLimit(cmd, body, opts.RateLimitWindowSize, opts.RateLimitWindowCount); err != nil {
+		return nil, err
+	}
+	if len(body) == 0 {
+		return nil, fmt.Errorf("nothing to update: pass --status, --rate-limit-window-size, or --rate-limit-window-count")
+	}
+	return body, nil
+}
+
+func applyTokenRateLimit(cmd *cobra.Command, body map[string]any, windowSize int64, windowCount int64) error {
+	if cmd.Flags().Changed("rate-limit-window-size") {
+		if windowSize <= 0 {
+			return fmt.Errorf("--rate-limit-window-size must be > 0")
+		}
+		body["rate_limit_window_size"] = windowSize
+	}
+	if cmd.Flags().Changed("rate-limit-window-count") {
+		if windowCount < 0 {
+			return fmt.Errorf("--rate-limit-window-count must be >= 0")
+		}
+		body["rate_limit_window_count"] = windowCount
+	}
+	return nil
+}
+
+func normalizeTokenScopes(raw []string) ([]string, error) {
+	scopes := make([]string, 0, len(raw))
+	for _, scope := range raw {
+		scope = strings.TrimSpace(strings.ToLower(scope))
+		if scope == "" {
+			continue
+		}
+		if !slices.Contains(rollbar.ProjectTokenScopes, scope) {
+			return nil, fmt.Errorf("invalid token scope %q: expected %s", scope, strings.Join(rollbar.ProjectTokenScopes, "|"))
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
+
+func normalizeTokenStatus(raw string) (string, error) {
+	status := strings.TrimSpace(strings.ToLower(raw))
+	if status == "" {
+		return "", nil
+	}
+	if _, ok := validTokenStatuses[status]; !ok {
+		return "", fmt.Errorf("invalid token status %q: expected enabled|disabled", raw)
+	}
+	return status, nil
+}
+
+func findProjectToken(tokens []rollbar.ProjectAccessToken, ref string) (rollbar.ProjectAccessToken, error) {
+	ref = strings.TrimSpace(ref)
+	for _, token := range tokens {
+		if token.AccessToken == ref {
+			return token, nil
+		}
+	}
+
+	var matches []rollbar.ProjectAccessToken
+	for _, token := range tokens {
+		if strings.EqualFold(token.Name, ref) {
+			matches = append(matches, token)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return rollbar.ProjectAccessToken{}, fmt.Errorf("no access token matches %q", ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return rollbar.ProjectAccessToken{}, fmt.Errorf("%d access tokens are named %q: pass the token value instead", len(matches), ref)
+	}
+}
+
+func rotatedTokenBody(old rollbar.ProjectAccessToken, name string) map[string]any {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = old.Name
+	}
+	body := map[string]any{
+		"name":   name,
+		"scopes": old.Scopes,
+		"status": "enabled",
+	}
+	if old.RateLimitWindowSize > 0 {
+		body["rate_limit_window_size"] = old.RateLimitWindowSize
+		body["rate_limit_window_count"] = old.RateLimitWindowCount
+	}
+	return body
+}
+
+func writeSingleTokenOutput(filter outputFilter, token rollbar.ProjectAccessToken, raw map[string]any, output string) error {
+	switch output {
+	case outputRawJSON:
+		return writeJSON(filter, raw)
+	case outputJSON:
+		return writeJSON(filter, tokenJSONOutput{Token: token})
+	case outputNDJSON:
+		return writeNDJSON(filter, []any{token})
+	default:
+		return ui.RenderProjectToken(token)
+	}
+}
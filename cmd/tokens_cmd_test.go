@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+func TestTokensRotateWritesProfileAndDisablesOldToken(t *testing.T) {
+	var gotRequests []string
+	var createBody, patchBody map[string]any
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequests = append(gotRequests, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"err":0,"result":[{"access_token":"old-token","name":"ci","status":"enabled","scopes":["read","write"],"rate_limit_window_size":60,"rate_limit_window_count":100}]}`))
+		case http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&createBody)
+			_, _ = w.Write([]byte(`{"err":0,"result":{"access_token":"new-token","name":"ci","status":"enabled","scopes":["read","write"]}}`))
+		case http.MethodPatch:
+			_ = json.NewDecoder(r.Body).Decode(&patchBody)
+			_, _ = w.Write([]byte(`{"err":0,"result":{}}`))
+		}
+	}))
+	defer ts.Close()
+
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"profiles":{"ci":{"timeout":"9s","token":"old-token","note":"<ops & on-call>"}},"default_profile":"ci"}`), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	out, err := runCLIWithCapturedStdout(t,
+		"tokens", "rotate", "ci",
+		"--project", "42",
+		"--write-profile",
+		"--json",
+		"--config", configPath,
+		"--account-token", "account-tok",
+		"--base-url", ts.URL,
+	)
+	if err != nil {
+		t.Fatalf("unexpected command error: %v", err)
+	}
+
+	if len(gotRequests) != 3 || gotRequests[2] != "PATCH /api/1/project/42/access_token/old-token" {
+		t.Fatalf("unexpected requests: %#v", gotRequests)
+	}
+	if createBody["name"] != "ci" || createBody["rate_limit_window_count"] != float64(100) {
+		t.Fatalf("unexpected create body: %#v", createBody)
+	}
+	if patchBody["status"] != "disabled" {
+		t.Fatalf("unexpected patch body: %#v", patchBody)
+	}
+	if !strings.Contains(out, "\"old_disabled\": true") || !strings.Contains(out, "new-token") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("read config: %v", err)
+	}
+	want := `{
+  "profiles": {
+    "ci": {
+      "timeout": "9s",
+      "token": "new-token",
+      "note": "<ops & on-call>"
+    }
+  },
+  "default_profile": "ci"
+}
+`
+	if string(data) != want {
+		t.Fatalf("unexpected config after rotation: %s", data)
+	}
+	if info, err := os.Stat(configPath); err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected the config to be rewritten with mode 0600, got %v, %v", info.Mode(), err)
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(configPath)); len(entries) != 1 {
+		t.Fatalf("expected no temp files left behind, got %d entries", len(entries))
+	}
+}
+
+func TestTokensRotateRefusesToOverwriteADifferentProfileToken(t *testing.T) {
+	var gotRequests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequests = append(gotRequests, r.Method)
+		_, _ = w.Write([]byte(`{"err":0,"result":[{"access_token":"ci-token","name":"ci","status":"enabled","scopes":["read"]}]}`))
+	}))
+	defer ts.Close()
+
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	config := `{"default_profile":"prod","profiles":{"prod":{"token":"prod-token"}}}`
+	if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	_, err := runCLIWithCapturedStdout(t,
+		"tokens", "rotate", "ci",
+		"--project", "42",
+		"--write-profile",
+		"--config", configPath,
+		"--account-token", "account-tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "does not hold token") {
+		t.Fatalf("expected a profile token mismatch error, got %v", err)
+	}
+	if len(gotRequests) != 1 || gotRequests[0] != http.MethodGet {
+		t.Fatalf("expected only the token list request, got %#v", gotRequests)
+	}
+	if data, _ := os.ReadFile(configPath); string(data) != config {
+		t.Fatalf("config was modified: %s", data)
+	}
+}
+
+func TestTokensRotateWithoutProfileFailsBeforeCreating(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer ts.Close()
+
+	t.Setenv("ROLLBAR_CLI_CONFIG", filepath.Join(t.TempDir(), "missing.json"))
+	_, err := runCLIWithCapturedStdout(t,
+		"tokens", "rotate", "ci",
+		"--project", "42",
+		"--write-profile",
+		"--account-token", "account-tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil {
+		t.Fatal("expected an error without a config profile")
+	}
+	if calls != 0 {
+		t.Fatalf("expected no API calls, got %d", calls)
+	}
+}
+
+func TestTokensCreateValidatesScopes(t *testing.T) {
+	_, err := runCLIWithCapturedStdout(t,
+		"tokens", "create",
+		"--project", "42",
+		"--name", "ci",
+		"--scope", "admin",
+		"--account-token", "account-tok",
+	)
+	if err == nil || !strings.Contains(err.Error(), "invalid token scope") {
+		t.Fatalf("expected scope validation error, got %v", err)
+	}
+}
+
+func TestFindProjectTokenAmbiguousName(t *testing.T) {
+	_, err := findProjectToken([]rollbar.ProjectAccessToken{{Name: "ci", AccessToken: "a"}, {Name: "CI", AccessToken: "b"}}, "ci")
+	if err == nil || !strings.Contains(err.Error(), "2 access tokens") {
+		t.Fatalf("expected ambiguity error, got %v", err)
+	}
+}
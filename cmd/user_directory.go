@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+const (
+	userDirectoryCacheTTL   = 10 * time.Minute
+	maxAmbiguousUserMatches = 5
+)
+
+type userDirectoryCache struct {
+	FetchedAt time.Time      `json:"fetched_at"`
+	Users     []rollbar.User `json:"users"`
+}
+
+// userDirectory resolves --user values against the account's users. The list
+// is cached on disk for a few minutes so repeated assignments stay fast.
+type userDirectory struct {
+	client    *rollbar.Client
+	cachePath string
+	now       func() time.Time
+}
+
+func newUserDirectory(cfg *cliConfig) *userDirectory {
+	return &userDirectory{
+		client:    newRollbarClient(cfg),
+		cachePath: userDirectoryCachePath(cfg),
+		now:       time.Now,
+	}
+}
+
+// Resolve accepts a numeric user ID as-is; anything else is matched against
+// usernames and emails. A miss against cached data refetches once in case the
+// user was added since the cache was written.
+func (d *userDirectory) Resolve(ctx context.Context, ref string) (rollbar.User, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return rollbar.User{}, fmt.Errorf("missing user: pass a username, email, or user ID")
+	}
+	if isIntegerToken(ref) {
+		id, err := strconv.ParseInt(ref, 10, 64)
+		if err != nil || id <= 0 {
+			return rollbar.User{}, fmt.Errorf("invalid user id %q: must be > 0", ref)
+		}
+		return rollbar.User{ID: id}, nil
+	}
+
+	users, cached := d.cachedUsers()
+	if cached {
+		user, err := findUser(users, ref)
+		var noMatch *noUserMatchError
+		if !errors.As(err, &noMatch) {
+			return user, err
+		}
+	}
+
+	users, err := d.fetchUsers(ctx)
+	if err != nil {
+		return rollbar.User{}, err
+	}
+	return findUser(users, ref)
+}
+
+func (d *userDirectory) cachedUsers() ([]rollbar.User, bool) {
+	if d.cachePath == "" {
+		return nil, false
+	}
+	data, err := os.ReadFile(d.cachePath)
+	if err != nil {
+		return nil, false
+	}
+	var cache userDirectoryCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, false
+	}
+	if d.now().Sub(cache.FetchedAt) > userDirectoryCacheTTL {
+		return nil, false
+	}
+	return cache.Users, true
+}
+
+func (d *userDirectory) fetchUsers(ctx context.Context) ([]rollbar.User, error) {
+	resp, err := d.client.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The cache is only an optimisation, so failing to write it is not an error.
+	if d.cachePath != "" {
+		if data, err := json.Marshal(userDirectoryCache{FetchedAt: d.now(), Users: resp.Users}); err == nil {
+			if err := os.MkdirAll(filepath.Dir(d.cachePath), 0o700); err == nil {
+				_ = os.WriteFile(d.cachePath, data, 0o600)
+			}
+		}
+	}
+	return resp.Users, nil
+}
+
+// userDirectoryCachePath keys the cache by API endpoint and token so that
+// profiles pointing at different accounts never share a user list.
+func userDirectoryCachePath(cfg *cliConfig) string {
+	dir := strings.TrimSpace(os.Getenv("ROLLBAR_CLI_CACHE_DIR"))
+	if dir == "" {
+		base, err := os.UserCacheDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(base, "rollbar-cli")
+	}
+	sum := sha256.Sum256([]byte(cfg.BaseURL + "\x00" + cfg.Token))
+	return filepath.Join(dir, "users-"+hex.EncodeToString(sum[:8])+".json")
+}
+
+type noUserMatchError struct {
+	Query string
+}
+
+func (e *noUserMatchError) Error() string {
+	return fmt.Sprintf("no user matches %q", e.Query)
+}
+
+// findUser prefers an exact username or email match, then a unique prefix
+// match, then a unique substring match. Comparisons ignore case.
+func findUser(users []rollbar.User, query string) (rollbar.User, error) {
+	q := strings.ToLower(strings.TrimSpace(query))
+
+	var exact, prefix, contains []rollbar.User
+	for _, user := range users {
+		username := strings.ToLower(user.Username)
+		email := strings.ToLower(user.Email)
+		switch {
+		case username == q || email == q:
+			exact = append(exact, user)
+		case strings.HasPrefix(username, q) || strings.HasPrefix(email, q):
+			prefix = append(prefix, user)
+		case strings.Contains(username, q) || strings.Contains(email, q):
+			contains = append(contains, user)
+		}
+	}
+
+	for _, matches := range [][]rollbar.User{exact, prefix, contains} {
+		switch len(matches) {
+		case 0:
+			continue
+		case 1:
+			return matches[0], nil
+		default:
+			return rollbar.User{}, ambiguousUserError(query, matches)
+		}
+	}
+	return rollbar.User{}, &noUserMatchError{Query: query}
+}
+
+func ambiguousUserError(query string, matches []rollbar.User) error {
+	names := make([]string, 0, maxAmbiguousUserMatches)
+	for i, user := range matches {
+		if i == maxAmbiguousUserMatches {
+			names = append(names, fmt.Sprintf("and %d more", len(matches)-i))
+			break
+		}
+		label := user.Username
+		if user.Email != "" {
+			label = strings.TrimSpace(label + " <" + user.Email + ">")
+		}
+		names = append(names, fmt.Sprintf("%s (id %d)", label, user.ID))
+	}
+	return fmt.Errorf("user %q is ambiguous: matches %s; use a more specific value or --assigned-user-id", query, strings.Join(names, ", "))
+}
+
+// resolveUserFlag resolves --user for item assignment commands.
+func resolveUserFlag(cmd *cobra.Command, cfg *cliConfig, ref string) (int64, error) {
+	user, err := newUserDirectory(cfg).Resolve(cmd.Context(), ref)
+	if err != nil {
+		return 0, err
+	}
+	return user.ID, nil
+}
+
+func validateUserFlag(cmd *cobra.Command, clearAssignedUser bool) error {
+	if !cmd.Flags().Changed("user") {
+		return nil
+	}
+	if cmd.Flags().Changed("assigned-user-id") {
+		return fmt.Errorf("use either --user or --assigned-user-id, not both")
+	}
+	if clearAssignedUser {
+		return fmt.Errorf("use either --user or --clear-assigned-user, not both")
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+func TestFindUser(t *testing.T) {
+	users := []rollbar.User{
+		{ID: 1, Username: "alice", Email: "alice@example.com"},
+		{ID: 2, Username: "alicia", Email: "alicia@example.com"},
+		{ID: 3, Username: "bob", Email: "robert@example.com"},
+	}
+
+	tests := []struct {
+		query   string
+		wantID  int64
+		wantErr string
+	}{
+		{query: "alice", wantID: 1},
+		{query: "ALICE@example.com", wantID: 1},
+		{query: "robert", wantID: 3},
+		{query: "ob", wantID: 3},
+		{query: "ali", wantErr: "is ambiguous"},
+		{query: "carol", wantErr: "no user matches"},
+	}
+	for _, tc := range tests {
+		got, err := findUser(users, tc.query)
+		if tc.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("findUser(%q) error = %v, want %q", tc.query, err, tc.wantErr)
+			}
+			continue
+		}
+		if err != nil || got.ID != tc.wantID {
+			t.Fatalf("findUser(%q) = %#v, %v; want id %d", tc.query, got, err, tc.wantID)
+		}
+	}
+}
+
+func TestUserDirectoryCachesUsers(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"err":0,"result":{"users":[{"id":1,"username":"alice","email":"alice@example.com"}]}}`))
+	}))
+	defer ts.Close()
+
+	now := time.Unix(1700000000, 0)
+	dir := &userDirectory{
+		client:    rollbar.NewClient(rollbar.Config{AccessToken: "tok", BaseURL: ts.URL}),
+		cachePath: filepath.Join(t.TempDir(), "users.json"),
+		now:       func() time.Time { return now },
+	}
+
+	for i := 0; i < 2; i++ {
+		user, err := dir.Resolve(context.Background(), "alice")
+		if err != nil || user.ID != 1 {
+			t.Fatalf("Resolve() = %#v, %v", user, err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected cached second lookup, got %d calls", calls)
+	}
+
+	// Unknown users bypass the cache once in case the directory changed.
+	if _, err := dir.Resolve(context.Background(), "carol"); err == nil {
+		t.Fatal("expected no match error")
+	}
+	if calls != 2 {
+		t.Fatalf("expected refetch on miss, got %d calls", calls)
+	}
+
+	now = now.Add(userDirectoryCacheTTL + time.Second)
+	if _, err := dir.Resolve(context.Background(), "alice"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected expired cache to refetch, got %d calls", calls)
+	}
+}
+
+func TestItemsAssignUserFlag(t *testing.T) {
+	t.Setenv("ROLLBAR_CLI_CACHE_DIR", t.TempDir())
+
+	var paths []string
+	var gotBody string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/api/1/users":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"users":[{"id":321,"username":"alice","email":"alice@example.com"},{"id":322,"username":"bob","email":"bob@example.com"}]}}`))
+		case "/api/1/item/42":
+			body, _ := io.ReadAll(r.Body)
+			gotBody = string(body)
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":42,"title":"boom"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	for i := 0; i < 2; i++ {
+		_, err := runCLIWithCapturedStdout(t,
+			"items", "assign", "42",
+			"--user", "alice@example.com",
+			"--json",
+			"--token", "tok",
+			"--base-url", ts.URL,
+		)
+		if err != nil {
+			t.Fatalf("unexpected command error: %v", err)
+		}
+		if !strings.Contains(gotBody, `"assigned_user_id":321`) {
+			t.Fatalf("unexpected update body: %s", gotBody)
+		}
+	}
+	want := []string{"GET /api/1/users", "GET /api/1/item/42", "PATCH /api/1/item/42", "GET /api/1/item/42", "PATCH /api/1/item/42"}
+	if strings.Join(paths, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected cached user directory, got requests %#v", paths)
+	}
+
+	_, err := runCLIWithCapturedStdout(t,
+		"items", "update", "42",
+		"--user", "alice",
+		"--assigned-user-id", "7",
+		"--token", "tok",
+		"--base-url", ts.URL,
+	)
+	if err == nil || !strings.Contains(err.Error(), "--user or --assigned-user-id") {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+}
@@ -25,10 +25,12 @@ type usersGetOptions struct {
 	JSON    bool
 	RawJSON bool
 	NDJSON  bool
+	Fields  []string
 }
 
 type userListJSONOutput struct {
-	Users []rollbar.User `json:"users"`
+	SchemaVersion int            `json:"schema_version"`
+	Users         []rollbar.User `json:"users"`
 }
 
 type userGetJSONOutput struct {
@@ -52,7 +54,7 @@ func newUsersCmd(cfg *cliConfig) *cobra.Command {
 				return err
 			}
 
-			output, err := resolveOutputModeWithAliases(listOpts.Output, listOpts.JSON, listOpts.RawJSON, listOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, listOpts.Output, listOpts.JSON, listOpts.RawJSON, listOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
 			if err != nil {
 				return err
 			}
@@ -65,15 +67,17 @@ func newUsersCmd(cfg *cliConfig) *cobra.Command {
 
 			switch output {
 			case outputRawJSON:
-				return writeJSON(resp.Raw)
+				return writeJSON(cfg.Filter, resp.Raw)
 			case outputJSON:
-				return writeJSON(userListJSONOutput{Users: resp.Users})
+				return writeJSON(cfg.Filter, userListJSONOutput{SchemaVersion: jsonSchemaVersion, Users: resp.Users})
 			case outputNDJSON:
 				records := make([]any, 0, len(resp.Users))
 				for _, user := range resp.Users {
 					records = append(records, user)
 				}
-				return writeNDJSON(records)
+				return writeNDJSON(cfg.Filter, records)
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.UsersTable(resp.Users, normalizeFields(listOpts.Fields)), listOpts.NoHeaders)
 			default:
 				return ui.RenderUsersWithOptions(resp.Users, ui.UserRenderOptions{
 					Fields:    normalizeFields(listOpts.Fields),
@@ -92,7 +96,7 @@ func newUsersCmd(cfg *cliConfig) *cobra.Command {
 				return err
 			}
 
-			output, err := resolveOutputModeWithAliases(getOpts.Output, getOpts.JSON, getOpts.RawJSON, getOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON)
+			output, err := resolveOutputModeWithAliases(cfg.Filter, getOpts.Output, getOpts.JSON, getOpts.RawJSON, getOpts.NDJSON, outputText, outputJSON, outputRawJSON, outputNDJSON, outputCSV, outputTSV, outputYAML, outputMarkdown)
 			if err != nil {
 				return err
 			}
@@ -110,29 +114,32 @@ func newUsersCmd(cfg *cliConfig) *cobra.Command {
 
 			switch output {
 			case outputRawJSON:
-				return writeJSON(resp.Raw)
+				return writeJSON(cfg.Filter, resp.Raw)
 			case outputJSON:
-				return writeJSON(userGetJSONOutput{User: resp.User})
+				return writeJSON(cfg.Filter, userGetJSONOutput{User: resp.User})
 			case outputNDJSON:
-				return writeNDJSON([]any{resp.User})
+				return writeNDJSON(cfg.Filter, []any{resp.User})
+			case outputCSV, outputTSV, outputYAML, outputMarkdown:
+				return writeTable(output, ui.UsersTable([]rollbar.User{resp.User}, normalizeFields(getOpts.Fields)), false)
 			default:
 				return ui.RenderUser(resp.User)
 			}
 		},
 	}
 
-	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson")
+	listCmd.Flags().StringVarP(&listOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
 	listCmd.Flags().BoolVar(&listOpts.JSON, "json", false, "Shortcut for --output json")
 	listCmd.Flags().BoolVar(&listOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
 	listCmd.Flags().BoolVar(&listOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
-	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text output")
-	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text output")
+	listCmd.Flags().StringSliceVar(&listOpts.Fields, "fields", nil, "Fields to render in text, csv, tsv, yaml, and markdown output")
+	listCmd.Flags().BoolVar(&listOpts.NoHeaders, "no-headers", false, "Hide table headers in text, csv, and tsv output")
 
 	getCmd.Flags().Int64Var(&getOpts.ID, "id", 0, "User ID")
-	getCmd.Flags().StringVarP(&getOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson")
+	getCmd.Flags().StringVarP(&getOpts.Output, "output", "o", outputText, "Output format: text|json|raw-json|ndjson|csv|tsv|yaml|markdown|template")
 	getCmd.Flags().BoolVar(&getOpts.JSON, "json", false, "Shortcut for --output json")
 	getCmd.Flags().BoolVar(&getOpts.RawJSON, "raw-json", false, "Shortcut for --output raw-json")
 	getCmd.Flags().BoolVar(&getOpts.NDJSON, "ndjson", false, "Shortcut for --output ndjson")
+	getCmd.Flags().StringSliceVar(&getOpts.Fields, "fields", nil, "Fields to render in csv, tsv, yaml, and markdown output")
 
 	usersCmd.AddCommand(listCmd, getCmd)
 	return usersCmd
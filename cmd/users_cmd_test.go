@@ -88,7 +88,7 @@ func TestUsersGetCommandNDJSON(t *testing.T) {
 	if gotPath != "/api/1/user/7" {
 		t.Fatalf("unexpected request path: %s", gotPath)
 	}
-	if strings.Contains(out, "\"user\"") || !strings.Contains(out, "\"Username\":\"alice\"") {
+	if strings.Contains(out, "\"user\"") || !strings.Contains(out, "\"username\":\"alice\"") {
 		t.Fatalf("unexpected ndjson output: %q", out)
 	}
 }
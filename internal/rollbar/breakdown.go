@@ -0,0 +1,114 @@
+package rollbar
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// BreakdownMissing is the value counted for occurrences whose payload has
+// nothing at the path, so every breakdown adds up to its total.
+const BreakdownMissing = "(missing)"
+
+type BreakdownValue struct {
+	Value   string  `json:"value"`
+	Count   int64   `json:"count"`
+	Percent float64 `json:"percent"`
+}
+
+type Breakdown struct {
+	Path   string           `json:"path"`
+	Total  int64            `json:"total"`
+	Values []BreakdownValue `json:"values"`
+	// Other counts the occurrences whose value was cut by the top-N limit.
+	Other int64 `json:"other"`
+}
+
+// BreakdownCounter groups occurrences by the text of one or more payload
+// paths, as looked up by PayloadValue.
+type BreakdownCounter struct {
+	paths  []string
+	total  int64
+	counts []map[string]int64
+}
+
+func NewBreakdownCounter(paths []string) *BreakdownCounter {
+	counts := make([]map[string]int64, len(paths))
+	for i := range counts {
+		counts[i] = make(map[string]int64)
+	}
+	return &BreakdownCounter{paths: paths, counts: counts}
+}
+
+func (c *BreakdownCounter) Add(instance ItemInstance) {
+	c.total++
+	for i, path := range c.paths {
+		value := BreakdownMissing
+		if v, ok := PayloadValue(instance.Payload, path); ok && v != nil {
+			value = PayloadText(v)
+		}
+		c.counts[i][value]++
+	}
+}
+
+// Breakdowns returns one breakdown per path with values ordered by count,
+// busiest first. top > 0 keeps only that many values per path.
+func (c *BreakdownCounter) Breakdowns(top int) []Breakdown {
+	breakdowns := make([]Breakdown, 0, len(c.paths))
+	for i, path := range c.paths {
+		values := make([]BreakdownValue, 0, len(c.counts[i]))
+		for value, count := range c.counts[i] {
+			values = append(values, BreakdownValue{Value: value, Count: count, Percent: percentOf(count, c.total)})
+		}
+		slices.SortFunc(values, func(a, b BreakdownValue) int {
+			if a.Count != b.Count {
+				if a.Count > b.Count {
+					return -1
+				}
+				return 1
+			}
+			return strings.Compare(a.Value, b.Value)
+		})
+
+		breakdown := Breakdown{Path: path, Total: c.total}
+		if top > 0 && len(values) > top {
+			for _, v := range values[top:] {
+				breakdown.Other += v.Count
+			}
+			values = values[:top]
+		}
+		breakdown.Values = values
+		breakdowns = append(breakdowns, breakdown)
+	}
+	return breakdowns
+}
+
+func percentOf(count, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(count)/float64(total)*10000) / 100
+}
+
+// PayloadText formats a payload value for comparing or grouping: strings as
+// they are, numbers without exponents, and objects and arrays as JSON.
+func PayloadText(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return "null"
+	case bool, json.Number:
+		return fmt.Sprint(v)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,41 @@
+package rollbar
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBreakdownCounter(t *testing.T) {
+	counter := NewBreakdownCounter([]string{"server.host", "code_version"})
+	for _, payload := range []map[string]any{
+		{"server": map[string]any{"host": "web-1"}, "code_version": "abc"},
+		{"server": map[string]any{"host": "web-2"}, "code_version": "abc"},
+		{"data": map[string]any{"server": map[string]any{"host": "web-1"}}},
+		{"server": map[string]any{"host": "web-3"}, "code_version": float64(7)},
+	} {
+		counter.Add(ItemInstance{Payload: payload})
+	}
+
+	got := counter.Breakdowns(2)
+	want := []Breakdown{
+		{
+			Path:   "server.host",
+			Total:  4,
+			Values: []BreakdownValue{{Value: "web-1", Count: 2, Percent: 50}, {Value: "web-2", Count: 1, Percent: 25}},
+			Other:  1,
+		},
+		{
+			Path:   "code_version",
+			Total:  4,
+			Values: []BreakdownValue{{Value: "abc", Count: 2, Percent: 50}, {Value: BreakdownMissing, Count: 1, Percent: 25}},
+			Other:  1,
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Breakdowns() =\n%#v\nwant\n%#v", got, want)
+	}
+
+	if all := counter.Breakdowns(0); len(all[0].Values) != 3 || all[0].Other != 0 {
+		t.Fatalf("Breakdowns(0) should keep every value, got %#v", all[0])
+	}
+}
@@ -4,25 +4,53 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
+const (
+	defaultMaxAttempts  = 4
+	defaultRetryMaxWait = 60 * time.Second
+	retryBaseDelay      = 500 * time.Millisecond
+)
+
 type Config struct {
 	AccessToken string
 	BaseURL     string
 	Timeout     time.Duration
+	Retry       RetryConfig
+	// DryRun makes every non-GET request return a *DryRunError instead of
+	// reaching the API.
+	DryRun bool
+}
+
+type RetryConfig struct {
+	Disabled    bool
+	MaxAttempts int
+	MaxWait     time.Duration
 }
 
 type Client struct {
 	accessToken string
 	baseURL     string
 	httpClient  *http.Client
+	retry       RetryConfig
+	dryRun      bool
+	sleep       func(ctx context.Context, d time.Duration) error
+	now         func() time.Time
+
+	// counterIDs maps item counters to item IDs seen during this session, so
+	// a repeated counter lookup skips the item_by_counter redirect.
+	counterMu  sync.Mutex
+	counterIDs map[int64]int64
 }
 
 type ListItemsOptions struct {
@@ -30,6 +58,11 @@ type ListItemsOptions struct {
 	Status      string
 	Environment string
 	Level       []string
+	// AssignedUser is a username, or "assigned" or "unassigned".
+	AssignedUser string
+	Framework    string
+	// Query is the free-text search the Rollbar UI search box runs.
+	Query string
 }
 
 type ListDeploysOptions struct {
@@ -38,55 +71,78 @@ type ListDeploysOptions struct {
 }
 
 type Item struct {
-	ID                      int64
-	Counter                 int64
-	Title                   string
-	Level                   string
-	Status                  string
-	Environment             string
-	TotalOccurrences        int64
-	LastOccurrenceTimestamp int64
+	ID                       int64  `json:"id"`
+	ProjectID                int64  `json:"project_id,omitempty"`
+	Counter                  int64  `json:"counter"`
+	Title                    string `json:"title"`
+	Level                    string `json:"level"`
+	Status                   string `json:"status"`
+	Environment              string `json:"environment"`
+	Hash                     string `json:"hash,omitempty"`
+	Framework                string `json:"framework,omitempty"`
+	Platform                 string `json:"platform,omitempty"`
+	Language                 string `json:"language,omitempty"`
+	TotalOccurrences         int64  `json:"total_occurrences"`
+	UniqueOccurrences        int64  `json:"unique_occurrences,omitempty"`
+	FirstOccurrenceID        int64  `json:"first_occurrence_id,omitempty"`
+	FirstOccurrenceTimestamp int64  `json:"first_occurrence_timestamp,omitempty"`
+	LastOccurrenceTimestamp  int64  `json:"last_occurrence_timestamp"`
+	ActivatingOccurrenceID   int64  `json:"activating_occurrence_id,omitempty"`
+	AssignedUserID           int64  `json:"assigned_user_id,omitempty"`
+	AssignedTeamID           int64  `json:"assigned_team_id,omitempty"`
+	ResolvedInVersion        string `json:"resolved_in_version,omitempty"`
+	SnoozeEnabled            bool   `json:"snooze_enabled"`
+	SnoozeExpiresAt          int64  `json:"snooze_expires_at,omitempty"`
 }
 
 type User struct {
-	ID       int64
-	Username string
-	Email    string
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
 }
 
 type Environment struct {
-	ID        int64
-	ProjectID int64
-	Name      string
+	ID        int64  `json:"id"`
+	ProjectID int64  `json:"project_id"`
+	Name      string `json:"name"`
+}
+
+type Project struct {
+	ID           int64  `json:"id"`
+	AccountID    int64  `json:"account_id"`
+	Name         string `json:"name"`
+	Status       string `json:"status"`
+	DateCreated  int64  `json:"date_created"`
+	DateModified int64  `json:"date_modified"`
 }
 
 type Deploy struct {
-	ID              int64
-	ProjectID       int64
-	Environment     string
-	Revision        string
-	Status          string
-	Comment         string
-	LocalUsername   string
-	RollbarUsername string
-	StartTime       int64
-	FinishTime      int64
+	ID              int64  `json:"id"`
+	ProjectID       int64  `json:"project_id"`
+	Environment     string `json:"environment"`
+	Revision        string `json:"revision"`
+	Status          string `json:"status"`
+	Comment         string `json:"comment"`
+	LocalUsername   string `json:"local_username"`
+	RollbarUsername string `json:"rollbar_username"`
+	StartTime       int64  `json:"start_time"`
+	FinishTime      int64  `json:"finish_time"`
 }
 
 type StackFrame struct {
-	Filename string
-	Line     int64
-	Method   string
+	Filename string `json:"filename"`
+	Line     int64  `json:"line"`
+	Method   string `json:"method"`
 }
 
 type ItemInstance struct {
-	ID          int64
-	UUID        string
-	Level       string
-	Environment string
-	Timestamp   int64
-	StackFrames []StackFrame
-	Payload     map[string]any
+	ID          int64          `json:"id"`
+	UUID        string         `json:"uuid"`
+	Level       string         `json:"level"`
+	Environment string         `json:"environment"`
+	Timestamp   int64          `json:"timestamp"`
+	StackFrames []StackFrame   `json:"stack_frames"`
+	Payload     map[string]any `json:"payload"`
 }
 
 type ListItemsResponse struct {
@@ -114,6 +170,16 @@ type ListEnvironmentsResponse struct {
 	RawPages     []map[string]any
 }
 
+type ListProjectsResponse struct {
+	Projects []Project
+	Raw      map[string]any
+}
+
+type ProjectResponse struct {
+	Project Project
+	Raw     map[string]any
+}
+
 type GetUserResponse struct {
 	User User
 	Raw  map[string]any
@@ -171,6 +237,10 @@ type listEnvironmentsResult struct {
 	Environments []json.RawMessage `json:"environments"`
 }
 
+type listProjectsResult struct {
+	Projects []json.RawMessage `json:"projects"`
+}
+
 type listItemInstancesResult struct {
 	Instances []json.RawMessage `json:"instances"`
 }
@@ -189,38 +259,44 @@ func (c *Client) doJSON(ctx context.Context, method string, path string, query u
 		endpoint.RawQuery = query.Encode()
 	}
 
-	var body io.Reader
+	var rawPayload []byte
 	if payload != nil {
-		rawPayload, err := json.Marshal(payload)
+		rawPayload, err = json.Marshal(payload)
 		if err != nil {
 			return nil, fmt.Errorf("marshal request body: %w", err)
 		}
-		body = bytes.NewReader(rawPayload)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), body)
-	if err != nil {
-		return nil, fmt.Errorf("create request: %w", err)
 	}
-	req.Header.Set("X-Rollbar-Access-Token", c.accessToken)
-	req.Header.Set("Accept", "application/json")
-	if payload != nil {
-		req.Header.Set("Content-Type", "application/json")
+	if c.dryRun && method != http.MethodGet {
+		return nil, &DryRunError{Method: method, Path: path, Query: query, Body: rawPayload}
 	}
 
-	res, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+	maxAttempts := c.retry.MaxAttempts
+	if c.retry.Disabled || maxAttempts < 1 {
+		maxAttempts = 1
 	}
-	defer res.Body.Close()
 
-	responseBody, err := io.ReadAll(res.Body)
+	var statusCode int
+	var responseBody []byte
+	for attempt := 1; ; attempt++ {
+		var header http.Header
+		statusCode, header, responseBody, err = c.send(ctx, method, endpoint.String(), rawPayload)
+		if attempt >= maxAttempts {
+			break
+		}
+		wait, retry := c.retryDelay(method, attempt, statusCode, header, err)
+		if !retry {
+			break
+		}
+		if sleepErr := c.sleep(ctx, wait); sleepErr != nil {
+			return nil, fmt.Errorf("request failed: %w", sleepErr)
+		}
+	}
 	if err != nil {
-		return nil, fmt.Errorf("read response: %w", err)
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
-	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		return nil, fmt.Errorf("rollbar API error: status=%d body=%s", res.StatusCode, formatErrorBody(responseBody))
+	if statusCode < 200 || statusCode >= 300 {
+		return nil, newHTTPError(method, path, statusCode, responseBody)
 	}
 
 	var raw map[string]any
@@ -236,7 +312,13 @@ func (c *Client) doJSON(ctx context.Context, method string, path string, query u
 		if env.Message == "" {
 			env.Message = "unknown error"
 		}
-		return nil, fmt.Errorf("rollbar API returned err=%d: %s", env.Err, env.Message)
+		return nil, &APIError{
+			StatusCode: statusCode,
+			Code:       env.Err,
+			Message:    env.Message,
+			Method:     method,
+			Path:       path,
+		}
 	}
 
 	return &apiResponse{
@@ -245,6 +327,121 @@ func (c *Client) doJSON(ctx context.Context, method string, path string, query u
 	}, nil
 }
 
+func (c *Client) send(ctx context.Context, method string, endpoint string, rawPayload []byte) (int, http.Header, []byte, error) {
+	var body io.Reader
+	if rawPayload != nil {
+		body = bytes.NewReader(rawPayload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("X-Rollbar-Access-Token", c.accessToken)
+	req.Header.Set("Accept", "application/json")
+	if rawPayload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer res.Body.Close()
+
+	responseBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("read response: %w", err)
+	}
+	return res.StatusCode, res.Header, responseBody, nil
+}
+
+// retryDelay reports whether a failed attempt should be retried and how long to
+// wait first. Rate-limited requests were never processed, so they are retried
+// for every method; transport errors and 5xx responses only for idempotent ones.
+func (c *Client) retryDelay(method string, attempt int, statusCode int, header http.Header, err error) (time.Duration, bool) {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false
+		}
+		return c.backoff(attempt), isIdempotentMethod(method)
+	}
+
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		if wait, ok := c.rateLimitWait(header); ok {
+			if wait > c.maxWait() {
+				return 0, false
+			}
+			return wait, true
+		}
+		return c.backoff(attempt), true
+	case statusCode >= 500 && statusCode != http.StatusNotImplemented:
+		return c.backoff(attempt), isIdempotentMethod(method)
+	default:
+		return 0, false
+	}
+}
+
+func (c *Client) rateLimitWait(header http.Header) (time.Duration, bool) {
+	if header == nil {
+		return 0, false
+	}
+	if remaining := strings.TrimSpace(header.Get("X-Rate-Limit-Remaining")); remaining != "" && remaining != "0" {
+		return 0, false
+	}
+	if reset, err := strconv.ParseInt(strings.TrimSpace(header.Get("X-Rate-Limit-Reset")), 10, 64); err == nil && reset > 0 {
+		wait := time.Unix(reset, 0).Sub(c.now())
+		if wait < 0 {
+			wait = 0
+		}
+		return wait + time.Second, true
+	}
+	if seconds, err := strconv.Atoi(strings.TrimSpace(header.Get("Retry-After"))); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	return 0, false
+}
+
+func (c *Client) backoff(attempt int) time.Duration {
+	delay := retryBaseDelay << (attempt - 1)
+	if max := c.maxWait(); delay > max || delay <= 0 {
+		delay = max
+	}
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+func (c *Client) maxWait() time.Duration {
+	if c.retry.MaxWait <= 0 {
+		return defaultRetryMaxWait
+	}
+	return c.retry.MaxWait
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func formatErrorBody(body []byte) string {
 	trimmed := strings.TrimSpace(string(body))
 	if trimmed == "" {
@@ -276,10 +473,22 @@ func NewClient(cfg Config) *Client {
 	if timeout <= 0 {
 		timeout = 15 * time.Second
 	}
+	retry := cfg.Retry
+	if retry.MaxAttempts <= 0 {
+		retry.MaxAttempts = defaultMaxAttempts
+	}
+	if retry.MaxWait <= 0 {
+		retry.MaxWait = defaultRetryMaxWait
+	}
 	return &Client{
 		accessToken: cfg.AccessToken,
 		baseURL:     baseURL,
 		httpClient:  &http.Client{Timeout: timeout},
+		retry:       retry,
+		dryRun:      cfg.DryRun,
+		sleep:       sleepContext,
+		now:         time.Now,
+		counterIDs:  make(map[int64]int64),
 	}
 }
 
@@ -303,6 +512,15 @@ func (c *Client) ListItems(ctx context.Context, opts ListItemsOptions) (*ListIte
 			query.Add("level", level)
 		}
 	}
+	if opts.AssignedUser != "" {
+		query.Set("assigned_user", opts.AssignedUser)
+	}
+	if opts.Framework != "" {
+		query.Set("framework", opts.Framework)
+	}
+	if opts.Query != "" {
+		query.Set("query", opts.Query)
+	}
 	resp, err := c.doJSON(ctx, http.MethodGet, "/api/1/items", query, nil)
 	if err != nil {
 		return nil, err
@@ -323,10 +541,26 @@ func (c *Client) ListItems(ctx context.Context, opts ListItemsOptions) (*ListIte
 		}
 		items = append(items, item)
 	}
+	c.rememberCounters(items...)
 
 	return &ListItemsResponse{Items: items, Raw: resp.Raw}, nil
 }
 
+func (c *Client) ItemsPager(opts ListItemsOptions, pagerOpts PagerOptions) *Pager[Item] {
+	if pagerOpts.StartPage <= 0 {
+		pagerOpts.StartPage = opts.Page
+	}
+	return NewPager(func(ctx context.Context, page int) ([]Item, map[string]any, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		resp, err := c.ListItems(ctx, pageOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Raw, nil
+	}, pagerOpts)
+}
+
 func (c *Client) GetItemByID(ctx context.Context, id int64) (*GetItemResponse, error) {
 	if id <= 0 {
 		return nil, fmt.Errorf("invalid item id: must be > 0")
@@ -342,6 +576,32 @@ func (c *Client) GetItemByUUID(ctx context.Context, uuid string) (*GetItemRespon
 	return c.getItem(ctx, uuid)
 }
 
+// GetItemByCounter looks an item up by its project counter, the #1234 shown
+// in the Rollbar UI. The API answers with a redirect to the item, which the
+// HTTP client follows.
+func (c *Client) GetItemByCounter(ctx context.Context, counter int64) (*GetItemResponse, error) {
+	if counter <= 0 {
+		return nil, fmt.Errorf("invalid item counter: must be > 0")
+	}
+	c.counterMu.Lock()
+	id, ok := c.counterIDs[counter]
+	c.counterMu.Unlock()
+	if ok {
+		return c.getItem(ctx, strconv.FormatInt(id, 10))
+	}
+	return c.fetchItem(ctx, "/api/1/item_by_counter/"+strconv.FormatInt(counter, 10))
+}
+
+func (c *Client) rememberCounters(items ...Item) {
+	c.counterMu.Lock()
+	defer c.counterMu.Unlock()
+	for _, item := range items {
+		if item.Counter > 0 && item.ID > 0 {
+			c.counterIDs[item.Counter] = item.ID
+		}
+	}
+}
+
 func (c *Client) ListUsers(ctx context.Context) (*ListUsersResponse, error) {
 	if c.accessToken == "" {
 		return nil, fmt.Errorf("missing access token")
@@ -387,54 +647,152 @@ func (c *Client) ListEnvironments(ctx context.Context) (*ListEnvironmentsRespons
 		return nil, fmt.Errorf("missing access token")
 	}
 
-	environments := make([]Environment, 0)
-	rawPages := make([]map[string]any, 0)
+	environments, rawPages, err := c.EnvironmentsPager(PagerOptions{}).All(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &ListEnvironmentsResponse{Environments: environments, RawPages: rawPages}, nil
+}
+
+func (c *Client) EnvironmentsPager(opts PagerOptions) *Pager[Environment] {
+	return NewPager(c.listEnvironmentsPage, opts)
+}
 
-	for page := 1; ; page++ {
-		query := url.Values{}
-		query.Set("page", strconv.Itoa(page))
+func (c *Client) listEnvironmentsPage(ctx context.Context, page int) ([]Environment, map[string]any, error) {
+	if c.accessToken == "" {
+		return nil, nil, fmt.Errorf("missing access token")
+	}
 
-		resp, err := c.doJSON(ctx, http.MethodGet, "/api/1/environments", query, nil)
-		if err != nil {
-			return nil, err
-		}
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
 
-		var result listEnvironmentsResult
-		if len(resp.Envelope.Result) > 0 {
-			if err := json.Unmarshal(resp.Envelope.Result, &result); err != nil {
-				var directEnvironments []json.RawMessage
-				if directErr := json.Unmarshal(resp.Envelope.Result, &directEnvironments); directErr == nil {
-					result.Environments = directEnvironments
-				} else {
-					return nil, fmt.Errorf("parse result.environments: %w", err)
-				}
+	resp, err := c.doJSON(ctx, http.MethodGet, "/api/1/environments", query, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result listEnvironmentsResult
+	if len(resp.Envelope.Result) > 0 {
+		if err := json.Unmarshal(resp.Envelope.Result, &result); err != nil {
+			var directEnvironments []json.RawMessage
+			if directErr := json.Unmarshal(resp.Envelope.Result, &directEnvironments); directErr == nil {
+				result.Environments = directEnvironments
+			} else {
+				return nil, nil, fmt.Errorf("parse result.environments: %w", err)
 			}
-			if len(result.Environments) == 0 {
-				var directEnvironments []json.RawMessage
-				if err := json.Unmarshal(resp.Envelope.Result, &directEnvironments); err == nil {
-					result.Environments = directEnvironments
-				}
+		}
+		if len(result.Environments) == 0 {
+			var directEnvironments []json.RawMessage
+			if err := json.Unmarshal(resp.Envelope.Result, &directEnvironments); err == nil {
+				result.Environments = directEnvironments
 			}
 		}
+	}
 
-		pageEnvironments := make([]Environment, 0, len(result.Environments))
-		for idx, rawEnvironment := range result.Environments {
-			environment, err := normalizeEnvironment(rawEnvironment)
-			if err != nil {
-				return nil, fmt.Errorf("decode environment %d: %w", idx, err)
-			}
-			pageEnvironments = append(pageEnvironments, environment)
+	environments := make([]Environment, 0, len(result.Environments))
+	for idx, rawEnvironment := range result.Environments {
+		environment, err := normalizeEnvironment(rawEnvironment)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decode environment %d: %w", idx, err)
 		}
+		environments = append(environments, environment)
+	}
 
-		rawPages = append(rawPages, resp.Raw)
-		environments = append(environments, pageEnvironments...)
+	return environments, resp.Raw, nil
+}
 
-		if len(pageEnvironments) == 0 {
-			break
+func (c *Client) ListProjects(ctx context.Context) (*ListProjectsResponse, error) {
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodGet, "/api/1/projects", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result listProjectsResult
+	if len(resp.Envelope.Result) > 0 {
+		if err := json.Unmarshal(resp.Envelope.Result, &result.Projects); err != nil {
+			if nestedErr := json.Unmarshal(resp.Envelope.Result, &result); nestedErr != nil {
+				return nil, fmt.Errorf("parse result.projects: %w", err)
+			}
 		}
 	}
 
-	return &ListEnvironmentsResponse{Environments: environments, RawPages: rawPages}, nil
+	projects := make([]Project, 0, len(result.Projects))
+	for idx, rawProject := range result.Projects {
+		project, err := normalizeProject(rawProject)
+		if err != nil {
+			return nil, fmt.Errorf("decode project %d: %w", idx, err)
+		}
+		// Deleted projects come back as empty placeholders.
+		if project.ID == 0 {
+			continue
+		}
+		projects = append(projects, project)
+	}
+
+	return &ListProjectsResponse{Projects: projects, Raw: resp.Raw}, nil
+}
+
+func (c *Client) GetProject(ctx context.Context, id int64) (*ProjectResponse, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid project id: must be > 0")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodGet, "/api/1/project/"+strconv.FormatInt(id, 10), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := extractProjectResult(resp.Envelope.Result)
+	if err != nil {
+		return nil, err
+	}
+	if project.ID == 0 {
+		project.ID = id
+	}
+	return &ProjectResponse{Project: project, Raw: resp.Raw}, nil
+}
+
+func (c *Client) CreateProject(ctx context.Context, name string) (*ProjectResponse, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("missing project name")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodPost, "/api/1/projects", nil, map[string]any{"name": name})
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := extractProjectResult(resp.Envelope.Result)
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectResponse{Project: project, Raw: resp.Raw}, nil
+}
+
+func (c *Client) DeleteProject(ctx context.Context, id int64) (map[string]any, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid project id: must be > 0")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodDelete, "/api/1/project/"+strconv.FormatInt(id, 10), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Raw, nil
 }
 
 func (c *Client) ListDeploys(ctx context.Context, opts ListDeploysOptions) (*ListDeploysResponse, error) {
@@ -485,6 +843,21 @@ func (c *Client) ListDeploys(ctx context.Context, opts ListDeploysOptions) (*Lis
 	return &ListDeploysResponse{Deploys: deploys, Raw: resp.Raw}, nil
 }
 
+func (c *Client) DeploysPager(opts ListDeploysOptions, pagerOpts PagerOptions) *Pager[Deploy] {
+	if pagerOpts.StartPage <= 0 {
+		pagerOpts.StartPage = opts.Page
+	}
+	return NewPager(func(ctx context.Context, page int) ([]Deploy, map[string]any, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		resp, err := c.ListDeploys(ctx, pageOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Deploys, resp.Raw, nil
+	}, pagerOpts)
+}
+
 func (c *Client) GetDeployByID(ctx context.Context, id int64) (*GetDeployResponse, error) {
 	if id <= 0 {
 		return nil, fmt.Errorf("invalid deploy id: must be > 0")
@@ -629,6 +1002,16 @@ func (c *Client) ListItemInstances(ctx context.Context, identifier string, page
 	}, nil
 }
 
+func (c *Client) ItemInstancesPager(identifier string, pagerOpts PagerOptions) *Pager[ItemInstance] {
+	return NewPager(func(ctx context.Context, page int) ([]ItemInstance, map[string]any, error) {
+		resp, err := c.ListItemInstances(ctx, identifier, page)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Instances, resp.Raw, nil
+	}, pagerOpts)
+}
+
 func (c *Client) GetOccurrenceByID(ctx context.Context, id int64) (*GetOccurrenceResponse, error) {
 	if id <= 0 {
 		return nil, fmt.Errorf("invalid occurrence id: must be > 0")
@@ -681,10 +1064,14 @@ func (c *Client) UpdateItemByID(ctx context.Context, id int64, body map[string]a
 }
 
 func (c *Client) getItem(ctx context.Context, identifier string) (*GetItemResponse, error) {
+	return c.fetchItem(ctx, "/api/1/item/"+url.PathEscape(identifier))
+}
+
+func (c *Client) fetchItem(ctx context.Context, path string) (*GetItemResponse, error) {
 	if c.accessToken == "" {
 		return nil, fmt.Errorf("missing access token")
 	}
-	resp, err := c.doJSON(ctx, http.MethodGet, "/api/1/item/"+url.PathEscape(identifier), nil, nil)
+	resp, err := c.doJSON(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -704,6 +1091,7 @@ func (c *Client) getItem(ctx context.Context, identifier string) (*GetItemRespon
 	}
 
 	item := normalizeItemMap(itemData)
+	c.rememberCounters(item)
 	return &GetItemResponse{Item: item, Raw: resp.Raw}, nil
 }
 
@@ -751,14 +1139,28 @@ func normalizeItemMap(m map[string]any) Item {
 	}
 
 	item := Item{
-		ID:                      firstInt64(m, "id", "item_id"),
-		Counter:                 getInt64(m, "counter"),
-		Title:                   getString(m, "title"),
-		Level:                   getString(m, "level"),
-		Status:                  getString(m, "status"),
-		Environment:             getString(m, "environment"),
-		TotalOccurrences:        getInt64(m, "total_occurrences"),
-		LastOccurrenceTimestamp: getInt64(m, "last_occurrence_timestamp"),
+		ID:                       firstInt64(m, "id", "item_id"),
+		ProjectID:                getInt64(m, "project_id"),
+		Counter:                  getInt64(m, "counter"),
+		Title:                    getString(m, "title"),
+		Level:                    getString(m, "level"),
+		Status:                   getString(m, "status"),
+		Environment:              getString(m, "environment"),
+		Hash:                     firstString(m, "hash", "fingerprint"),
+		Framework:                getScalarString(m, "framework"),
+		Platform:                 getScalarString(m, "platform"),
+		Language:                 getString(m, "language"),
+		TotalOccurrences:         getInt64(m, "total_occurrences"),
+		UniqueOccurrences:        getInt64(m, "unique_occurrences"),
+		FirstOccurrenceID:        getInt64(m, "first_occurrence_id"),
+		FirstOccurrenceTimestamp: getInt64(m, "first_occurrence_timestamp"),
+		LastOccurrenceTimestamp:  getInt64(m, "last_occurrence_timestamp"),
+		ActivatingOccurrenceID:   getInt64(m, "activating_occurrence_id"),
+		AssignedUserID:           getInt64(m, "assigned_user_id"),
+		AssignedTeamID:           getInt64(m, "assigned_team_id"),
+		ResolvedInVersion:        getString(m, "resolved_in_version"),
+		SnoozeEnabled:            getBool(m, "snooze_enabled"),
+		SnoozeExpiresAt:          firstInt64(m, "snooze_end_time", "snooze_expiration"),
 	}
 
 	if item.Title == "" {
@@ -817,6 +1219,44 @@ func normalizeEnvironmentMap(m map[string]any) Environment {
 	}
 }
 
+func normalizeProject(rawProject json.RawMessage) (Project, error) {
+	var m map[string]any
+	if err := json.Unmarshal(rawProject, &m); err != nil {
+		return Project{}, err
+	}
+	return normalizeProjectMap(m), nil
+}
+
+func normalizeProjectMap(m map[string]any) Project {
+	if m == nil {
+		return Project{}
+	}
+
+	return Project{
+		ID:           firstInt64(m, "id", "project_id"),
+		AccountID:    firstInt64(m, "account_id", "accountId"),
+		Name:         firstString(m, "name"),
+		Status:       firstString(m, "status"),
+		DateCreated:  firstInt64(m, "date_created", "dateCreated"),
+		DateModified: firstInt64(m, "date_modified", "dateModified"),
+	}
+}
+
+func extractProjectResult(raw json.RawMessage) (Project, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return Project{}, nil
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return Project{}, fmt.Errorf("parse project result: %w", err)
+	}
+	if nested := getMap(result, "project"); nested != nil {
+		result = nested
+	}
+	return normalizeProjectMap(result), nil
+}
+
 func normalizeDeploy(rawDeploy json.RawMessage) (Deploy, error) {
 	var m map[string]any
 	if err := json.Unmarshal(rawDeploy, &m); err != nil {
@@ -1029,11 +1469,43 @@ func getString(data map[string]any, path ...string) string {
 	}
 }
 
-func getInt64(data map[string]any, path ...string) int64 {
+// getScalarString reads a value that the API returns as either a name or a
+// numeric code, such as an item's framework or platform.
+func getScalarString(data map[string]any, path ...string) string {
 	v, ok := walk(data, path...)
 	if !ok || v == nil {
+		return ""
+	}
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case json.Number:
+		return t.String()
+	default:
+		return ""
+	}
+}
+
+func getBool(data map[string]any, path ...string) bool {
+	v, ok := walk(data, path...)
+	if !ok {
+		return false
+	}
+	b, _ := v.(bool)
+	return b
+}
+
+func getInt64(data map[string]any, path ...string) int64 {
+	v, ok := walk(data, path...)
+	if !ok {
 		return 0
 	}
+	return int64Value(v)
+}
+
+func int64Value(v any) int64 {
 	switch t := v.(type) {
 	case float64:
 		return int64(t)
@@ -1052,21 +1524,50 @@ func getInt64(data map[string]any, path ...string) int64 {
 	}
 }
 
+// walk follows path through nested objects; a numeric segment indexes an
+// array.
 func walk(data map[string]any, path ...string) (any, bool) {
 	var cur any = data
 	for _, key := range path {
-		m, ok := cur.(map[string]any)
-		if !ok {
-			return nil, false
-		}
-		cur, ok = m[key]
-		if !ok {
+		switch node := cur.(type) {
+		case map[string]any:
+			value, ok := node[key]
+			if !ok {
+				return nil, false
+			}
+			cur = value
+		case []any:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, false
+			}
+			cur = node[idx]
+		default:
 			return nil, false
 		}
 	}
 	return cur, true
 }
 
+// PayloadValue looks up a dotted path such as "request.url" or
+// "body.trace.frames.0.filename" in an occurrence payload; numeric segments
+// index arrays. Rollbar keeps some sections at the top level of an occurrence
+// and others under "data", so a path that is missing at the top is retried
+// under data.
+func PayloadValue(payload map[string]any, path string) (any, bool) {
+	segments := strings.Split(strings.TrimSpace(path), ".")
+	if len(segments) == 0 || segments[0] == "" {
+		return nil, false
+	}
+	if value, ok := walk(payload, segments...); ok {
+		return value, true
+	}
+	if data, ok := payload["data"].(map[string]any); ok && segments[0] != "data" {
+		return walk(data, segments...)
+	}
+	return nil, false
+}
+
 func getMap(data map[string]any, path ...string) map[string]any {
 	v, ok := walk(data, path...)
 	if !ok || v == nil {
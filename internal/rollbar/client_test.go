@@ -3,6 +3,7 @@ package rollbar
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -79,6 +80,57 @@ func TestListItemsSuccess(t *testing.T) {
 	}
 }
 
+func TestListItemsSendsSearchFilters(t *testing.T) {
+	var gotQuery url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		_, _ = w.Write([]byte(`{"err":0,"result":{"items":[]}}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+	_, err := client.ListItems(context.Background(), ListItemsOptions{
+		AssignedUser: "ana",
+		Framework:    "rails",
+		Query:        "TimeoutError",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotQuery.Get("assigned_user") != "ana" || gotQuery.Get("framework") != "rails" || gotQuery.Get("query") != "TimeoutError" {
+		t.Fatalf("unexpected query: %v", gotQuery)
+	}
+}
+
+func TestPayloadValue(t *testing.T) {
+	payload := map[string]any{
+		"request": map[string]any{"url": "/checkout"},
+		"body":    map[string]any{"trace": map[string]any{"frames": []any{map[string]any{"filename": "app.py"}}}},
+		"data":    map[string]any{"person": map[string]any{"id": "42"}},
+	}
+	tests := map[string]any{
+		"request.url":                  "/checkout",
+		"body.trace.frames.0.filename": "app.py",
+		"person.id":                    "42",
+		"data.person.id":               "42",
+		"request.missing":              nil,
+		"body.trace.frames.3.filename": nil,
+		"request.url.deeper":           nil,
+	}
+	for path, want := range tests {
+		got, ok := PayloadValue(payload, path)
+		if want == nil {
+			if ok {
+				t.Errorf("PayloadValue(%q) = %v, want missing", path, got)
+			}
+			continue
+		}
+		if !ok || got != want {
+			t.Errorf("PayloadValue(%q) = %v, %v; want %v", path, got, ok, want)
+		}
+	}
+}
+
 func TestListItemsParsesStringID(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -172,6 +224,107 @@ func TestGetItemByIDAndUUID(t *testing.T) {
 	}
 }
 
+func TestGetItemByCounterFollowsRedirect(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.Header.Get("X-Rollbar-Access-Token") != "tok" {
+			t.Errorf("missing access token on %s", r.URL.Path)
+		}
+		if r.URL.Path == "/api/1/item_by_counter/7" {
+			http.Redirect(w, r, "/api/1/item/42", http.StatusMovedPermanently)
+			return
+		}
+		_, _ = w.Write([]byte(`{"err":0,"result":{"id":42,"counter":7,"title":"boom"}}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+	resp, err := client.GetItemByCounter(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("GetItemByCounter() error = %v", err)
+	}
+	if resp.Item.ID != 42 || resp.Item.Counter != 7 {
+		t.Fatalf("unexpected item: %#v", resp.Item)
+	}
+	if strings.Join(paths, ",") != "/api/1/item_by_counter/7,/api/1/item/42" {
+		t.Fatalf("unexpected requests: %v", paths)
+	}
+
+	paths = nil
+	if _, err := client.GetItemByCounter(context.Background(), 7); err != nil {
+		t.Fatalf("GetItemByCounter() second lookup error = %v", err)
+	}
+	if strings.Join(paths, ",") != "/api/1/item/42" {
+		t.Fatalf("expected a cached counter to skip the redirect, got %v", paths)
+	}
+
+	if _, err := client.GetItemByCounter(context.Background(), 0); err == nil {
+		t.Fatalf("expected invalid counter error")
+	}
+}
+
+func TestGetItemByCounterUsesListedItems(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/api/1/items":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"items":[{"id":42,"counter":7},{"id":43,"counter":8}]}}`))
+		case "/api/1/item/43":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":43,"counter":8}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+	if _, err := client.ListItems(context.Background(), ListItemsOptions{}); err != nil {
+		t.Fatalf("ListItems() error = %v", err)
+	}
+	resp, err := client.GetItemByCounter(context.Background(), 8)
+	if err != nil {
+		t.Fatalf("GetItemByCounter() error = %v", err)
+	}
+	if resp.Item.ID != 43 || strings.Join(paths, ",") != "/api/1/items,/api/1/item/43" {
+		t.Fatalf("unexpected lookup: item=%d requests=%v", resp.Item.ID, paths)
+	}
+}
+
+func TestNormalizeItemMapRichFields(t *testing.T) {
+	var m map[string]any
+	raw := `{"id":42,"project_id":9,"counter":7,"title":"boom","hash":"abc123","framework":4,"platform":"browser","language":"javascript",
+		"unique_occurrences":3,"first_occurrence_id":100,"first_occurrence_timestamp":1700000000,"activating_occurrence_id":101,
+		"assigned_user_id":321,"assigned_team_id":null,"resolved_in_version":"v1.2","snooze_enabled":true,"snooze_end_time":1700003600}`
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	item := normalizeItemMap(m)
+	want := Item{
+		ID: 42, ProjectID: 9, Counter: 7, Title: "boom", Hash: "abc123", Framework: "4", Platform: "browser", Language: "javascript",
+		UniqueOccurrences: 3, FirstOccurrenceID: 100, FirstOccurrenceTimestamp: 1700000000, ActivatingOccurrenceID: 101,
+		AssignedUserID: 321, ResolvedInVersion: "v1.2", SnoozeEnabled: true, SnoozeExpiresAt: 1700003600,
+	}
+	if item != want {
+		t.Fatalf("unexpected item:\n got %#v\nwant %#v", item, want)
+	}
+
+	encoded, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	for _, key := range []string{`"id":42`, `"first_occurrence_timestamp":1700000000`, `"assigned_user_id":321`, `"snooze_enabled":true`} {
+		if !strings.Contains(string(encoded), key) {
+			t.Fatalf("expected %s in %s", key, encoded)
+		}
+	}
+	if strings.Contains(string(encoded), "assigned_team_id") {
+		t.Fatalf("expected unset team to be omitted: %s", encoded)
+	}
+}
+
 func TestListUsers(t *testing.T) {
 	var gotPath string
 	var gotToken string
@@ -432,6 +585,68 @@ func TestListEnvironments(t *testing.T) {
 	}
 }
 
+func TestProjectsCRUD(t *testing.T) {
+	var gotRequests []string
+	var gotBody map[string]any
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequests = append(gotRequests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/1/projects":
+			_, _ = w.Write([]byte(`{"err":0,"result":[{"id":11,"account_id":3,"name":"web","status":"enabled","date_created":1700000000},null,{}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/1/project/11":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":11,"account_id":3,"name":"web","status":"enabled"}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/1/projects":
+			_ = json.NewDecoder(r.Body).Decode(&gotBody)
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":12,"account_id":3,"name":"api","status":"enabled"}}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/1/project/12":
+			_, _ = w.Write([]byte(`{"err":0,"result":{}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "account-tok", BaseURL: ts.URL})
+	list, err := client.ListProjects(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected list projects error: %v", err)
+	}
+	if len(list.Projects) != 1 || list.Projects[0].ID != 11 || list.Projects[0].AccountID != 3 || list.Projects[0].Name != "web" {
+		t.Fatalf("unexpected projects: %#v", list.Projects)
+	}
+
+	got, err := client.GetProject(context.Background(), 11)
+	if err != nil {
+		t.Fatalf("unexpected get project error: %v", err)
+	}
+	if got.Project.Status != "enabled" {
+		t.Fatalf("unexpected project: %#v", got.Project)
+	}
+
+	created, err := client.CreateProject(context.Background(), " api ")
+	if err != nil {
+		t.Fatalf("unexpected create project error: %v", err)
+	}
+	if created.Project.ID != 12 || gotBody["name"] != "api" {
+		t.Fatalf("unexpected create: project=%#v body=%#v", created.Project, gotBody)
+	}
+
+	if _, err := client.DeleteProject(context.Background(), 12); err != nil {
+		t.Fatalf("unexpected delete project error: %v", err)
+	}
+	if len(gotRequests) != 4 || gotRequests[3] != "DELETE /api/1/project/12" {
+		t.Fatalf("unexpected requests: %#v", gotRequests)
+	}
+
+	if _, err := client.CreateProject(context.Background(), " "); err == nil {
+		t.Fatal("expected empty project name to fail")
+	}
+	if _, err := client.GetProject(context.Background(), 0); err == nil {
+		t.Fatal("expected invalid project id to fail")
+	}
+}
+
 func TestListEnvironmentsDirectArray(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Query().Get("page") {
@@ -757,3 +972,217 @@ func TestFormatErrorBodyTruncatesAndPrefersMessage(t *testing.T) {
 		t.Fatalf("expected truncated body, got %q", got)
 	}
 }
+
+func TestDoJSONRetriesTransientErrors(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"err":0,"result":{"items":[{"id":1}]}}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+	var waits []time.Duration
+	client.sleep = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+
+	resp, err := client.ListItems(context.Background(), ListItemsOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 3 || len(resp.Items) != 1 {
+		t.Fatalf("expected success on third attempt, got attempts=%d items=%d", attempts, len(resp.Items))
+	}
+	if len(waits) != 2 || waits[0] < retryBaseDelay/2 || waits[0] > retryBaseDelay || waits[1] < retryBaseDelay || waits[1] > 2*retryBaseDelay {
+		t.Fatalf("unexpected backoff waits: %#v", waits)
+	}
+}
+
+func TestDoJSONWaitsForRateLimitReset(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("X-Rate-Limit-Remaining", "0")
+			w.Header().Set("X-Rate-Limit-Reset", "1700000010")
+			http.Error(w, `{"err":1,"message":"rate limited"}`, http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"err":0,"result":{"id":5,"status":"resolved"}}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+	client.now = func() time.Time { return now }
+	var waits []time.Duration
+	client.sleep = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+
+	if _, err := client.UpdateItemByID(context.Background(), 5, map[string]any{"status": "resolved"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected rate-limited PATCH to be retried once, got %d attempts", attempts)
+	}
+	if len(waits) != 1 || waits[0] != 11*time.Second {
+		t.Fatalf("expected wait until reset, got %#v", waits)
+	}
+}
+
+func TestDoJSONRetryLimits(t *testing.T) {
+	t.Run("non-idempotent 5xx", func(t *testing.T) {
+		attempts := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			http.Error(w, "bad", http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+		client.sleep = func(context.Context, time.Duration) error { return nil }
+		if _, err := client.CreateDeploy(context.Background(), map[string]any{"revision": "abc"}); err == nil {
+			t.Fatalf("expected error")
+		}
+		if attempts != 1 {
+			t.Fatalf("expected POST not to be retried, got %d attempts", attempts)
+		}
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		attempts := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			http.Error(w, "bad", http.StatusBadGateway)
+		}))
+		defer ts.Close()
+
+		client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL, Retry: RetryConfig{MaxAttempts: 2}})
+		client.sleep = func(context.Context, time.Duration) error { return nil }
+		if _, err := client.ListItems(context.Background(), ListItemsOptions{}); err == nil || !strings.Contains(err.Error(), "status=502") {
+			t.Fatalf("expected final status error, got %v", err)
+		}
+		if attempts != 2 {
+			t.Fatalf("expected 2 attempts, got %d", attempts)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		attempts := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer ts.Close()
+
+		client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL, Retry: RetryConfig{Disabled: true}})
+		if _, err := client.ListItems(context.Background(), ListItemsOptions{}); err == nil {
+			t.Fatalf("expected error")
+		}
+		if attempts != 1 {
+			t.Fatalf("expected a single attempt, got %d", attempts)
+		}
+	})
+
+	t.Run("reset beyond max wait", func(t *testing.T) {
+		attempts := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.Header().Set("X-Rate-Limit-Remaining", "0")
+			w.Header().Set("X-Rate-Limit-Reset", "1700000600")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer ts.Close()
+
+		client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL, Retry: RetryConfig{MaxWait: 30 * time.Second}})
+		client.now = func() time.Time { return time.Unix(1700000000, 0) }
+		client.sleep = func(context.Context, time.Duration) error {
+			t.Fatalf("did not expect to wait")
+			return nil
+		}
+		if _, err := client.ListItems(context.Background(), ListItemsOptions{}); err == nil || !strings.Contains(err.Error(), "status=429") {
+			t.Fatalf("expected rate-limit error, got %v", err)
+		}
+		if attempts != 1 {
+			t.Fatalf("expected a single attempt, got %d", attempts)
+		}
+	})
+}
+
+func TestAPIErrorsAreTyped(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/item/404":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"err":1,"message":"Item not found"}`))
+		case "/api/1/item/401":
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"err":1,"message":"invalid access token"}`))
+		default:
+			_, _ = w.Write([]byte(`{"err":2,"message":"nope"}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+
+	_, err := client.GetItemByID(context.Background(), 404)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %T %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Code != 1 || apiErr.Message != "Item not found" || apiErr.Path != "/api/1/item/404" || apiErr.Method != http.MethodGet {
+		t.Fatalf("unexpected APIError: %#v", apiErr)
+	}
+	if !errors.Is(err, ErrNotFound) || errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("unexpected sentinel matching for %v", err)
+	}
+
+	_, err = client.GetItemByID(context.Background(), 401)
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+
+	_, err = client.GetItemByID(context.Background(), 1)
+	if !errors.As(err, &apiErr) || apiErr.Code != 2 || apiErr.StatusCode != http.StatusOK {
+		t.Fatalf("expected envelope APIError, got %#v", err)
+	}
+	if !strings.Contains(err.Error(), "err=2: nope") {
+		t.Fatalf("unexpected envelope error message: %v", err)
+	}
+}
+
+func TestDryRunClientOnlySendsReads(t *testing.T) {
+	var methods []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		_, _ = w.Write([]byte(`{"err":0,"result":{"id":42,"status":"active"}}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL, DryRun: true})
+
+	if _, err := client.GetItemByID(context.Background(), 42); err != nil {
+		t.Fatalf("unexpected read error: %v", err)
+	}
+
+	_, err := client.UpdateItemByID(context.Background(), 42, map[string]any{"status": "resolved"})
+	var plan *DryRunError
+	if !errors.As(err, &plan) || !errors.Is(err, ErrDryRun) {
+		t.Fatalf("expected DryRunError, got %T %v", err, err)
+	}
+	if plan.Method != http.MethodPatch || plan.Path != "/api/1/item/42" || string(plan.Body) != `{"status":"resolved"}` {
+		t.Fatalf("unexpected dry-run plan: %#v", plan)
+	}
+	if strings.Join(methods, ",") != http.MethodGet {
+		t.Fatalf("dry run sent unexpected requests: %#v", methods)
+	}
+}
@@ -0,0 +1,146 @@
+package rollbar
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+const (
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+	DiffChanged = "changed"
+	DiffSame    = "same"
+)
+
+// PayloadChange is one path that differs between two payloads. Paths use the
+// dotted form accepted by PayloadValue.
+type PayloadChange struct {
+	Path   string `json:"path"`
+	Change string `json:"change"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+// FrameChange is one line of a stack-frame diff: a frame both traces share,
+// or one only the first (removed) or second (added) occurrence has.
+type FrameChange struct {
+	Change string     `json:"change"`
+	Frame  StackFrame `json:"frame"`
+}
+
+// DiffPayloads walks both payloads and reports every leaf that was added,
+// removed, or changed, in path order. Arrays are compared index by index.
+func DiffPayloads(before, after map[string]any) []PayloadChange {
+	changes := make([]PayloadChange, 0)
+	diffValues("", anyMap(before), anyMap(after), &changes)
+	return changes
+}
+
+func anyMap(m map[string]any) any {
+	if m == nil {
+		return map[string]any{}
+	}
+	return m
+}
+
+func diffValues(path string, before, after any, changes *[]PayloadChange) {
+	switch b := before.(type) {
+	case map[string]any:
+		if a, ok := after.(map[string]any); ok {
+			keys := make([]string, 0, len(b)+len(a))
+			for key := range b {
+				keys = append(keys, key)
+			}
+			for key := range a {
+				if _, ok := b[key]; !ok {
+					keys = append(keys, key)
+				}
+			}
+			slices.Sort(keys)
+			for _, key := range keys {
+				bv, inBefore := b[key]
+				av, inAfter := a[key]
+				child := joinPath(path, key)
+				switch {
+				case !inAfter:
+					*changes = append(*changes, PayloadChange{Path: child, Change: DiffRemoved, Before: bv})
+				case !inBefore:
+					*changes = append(*changes, PayloadChange{Path: child, Change: DiffAdded, After: av})
+				default:
+					diffValues(child, bv, av, changes)
+				}
+			}
+			return
+		}
+	case []any:
+		if a, ok := after.([]any); ok {
+			for i := 0; i < max(len(b), len(a)); i++ {
+				child := joinPath(path, strconv.Itoa(i))
+				switch {
+				case i >= len(a):
+					*changes = append(*changes, PayloadChange{Path: child, Change: DiffRemoved, Before: b[i]})
+				case i >= len(b):
+					*changes = append(*changes, PayloadChange{Path: child, Change: DiffAdded, After: a[i]})
+				default:
+					diffValues(child, b[i], a[i], changes)
+				}
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(before, after) {
+		*changes = append(*changes, PayloadChange{Path: path, Change: DiffChanged, Before: before, After: after})
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// DiffStackFrames lines two traces up on their longest common run of frames,
+// so a frame inserted near the top does not mark every frame below it as
+// changed.
+func DiffStackFrames(before, after []StackFrame) []FrameChange {
+	// lcs[i][j] is the common length of before[i:] and after[j:].
+	lcs := make([][]int, len(before)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(after)+1)
+	}
+	for i := len(before) - 1; i >= 0; i-- {
+		for j := len(after) - 1; j >= 0; j-- {
+			if before[i] == after[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	changes := make([]FrameChange, 0, max(len(before), len(after)))
+	i, j := 0, 0
+	for i < len(before) && j < len(after) {
+		switch {
+		case before[i] == after[j]:
+			changes = append(changes, FrameChange{Change: DiffSame, Frame: before[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			changes = append(changes, FrameChange{Change: DiffRemoved, Frame: before[i]})
+			i++
+		default:
+			changes = append(changes, FrameChange{Change: DiffAdded, Frame: after[j]})
+			j++
+		}
+	}
+	for ; i < len(before); i++ {
+		changes = append(changes, FrameChange{Change: DiffRemoved, Frame: before[i]})
+	}
+	for ; j < len(after); j++ {
+		changes = append(changes, FrameChange{Change: DiffAdded, Frame: after[j]})
+	}
+	return changes
+}
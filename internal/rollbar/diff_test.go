@@ -0,0 +1,53 @@
+package rollbar
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffPayloads(t *testing.T) {
+	before := map[string]any{
+		"request": map[string]any{"url": "https://shop.test/a", "body": "{}"},
+		"server":  map[string]any{"host": "web-1"},
+		"tags":    []any{"x", "y"},
+	}
+	after := map[string]any{
+		"request": map[string]any{"url": "https://shop.test/b"},
+		"server":  map[string]any{"host": "web-1"},
+		"tags":    []any{"x", "y", "z"},
+		"person":  map[string]any{"id": float64(42)},
+	}
+
+	got := DiffPayloads(before, after)
+	want := []PayloadChange{
+		{Path: "person", Change: DiffAdded, After: map[string]any{"id": float64(42)}},
+		{Path: "request.body", Change: DiffRemoved, Before: "{}"},
+		{Path: "request.url", Change: DiffChanged, Before: "https://shop.test/a", After: "https://shop.test/b"},
+		{Path: "tags.2", Change: DiffAdded, After: "z"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("DiffPayloads() =\n%#v\nwant\n%#v", got, want)
+	}
+
+	if got := DiffPayloads(nil, nil); len(got) != 0 {
+		t.Fatalf("expected no changes for empty payloads, got %#v", got)
+	}
+}
+
+func TestDiffStackFrames(t *testing.T) {
+	a := StackFrame{Filename: "app.py", Line: 10, Method: "handler"}
+	b := StackFrame{Filename: "app.py", Line: 20, Method: "charge"}
+	c := StackFrame{Filename: "app.py", Line: 21, Method: "charge"}
+	d := StackFrame{Filename: "db.py", Line: 5, Method: "query"}
+
+	got := DiffStackFrames([]StackFrame{a, b, d}, []StackFrame{a, c, d})
+	want := []FrameChange{
+		{Change: DiffSame, Frame: a},
+		{Change: DiffRemoved, Frame: b},
+		{Change: DiffAdded, Frame: c},
+		{Change: DiffSame, Frame: d},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("DiffStackFrames() =\n%#v\nwant\n%#v", got, want)
+	}
+}
@@ -0,0 +1,80 @@
+package rollbar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+var (
+	ErrUnauthorized = errors.New("rollbar: unauthorized")
+	ErrNotFound     = errors.New("rollbar: not found")
+	ErrRateLimited  = errors.New("rollbar: rate limited")
+	ErrServer       = errors.New("rollbar: server error")
+	ErrDryRun       = errors.New("rollbar: dry run")
+)
+
+// DryRunError is returned instead of sending a mutating request when the
+// client is in dry-run mode. It carries the exact request that would be sent.
+type DryRunError struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   json.RawMessage
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("dry run: %s %s was not sent", e.Method, e.Path)
+}
+
+func (e *DryRunError) Is(target error) bool {
+	return target == ErrDryRun
+}
+
+// APIError describes a failed Rollbar API call, either a non-2xx HTTP response
+// or a 2xx response whose envelope carries a non-zero err code.
+type APIError struct {
+	StatusCode int
+	Code       int
+	Message    string
+	Method     string
+	Path       string
+}
+
+func (e *APIError) Error() string {
+	if e.StatusCode >= 200 && e.StatusCode < 300 {
+		return fmt.Sprintf("rollbar API returned err=%d: %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("rollbar API error: status=%d body=%s", e.StatusCode, e.Message)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= 500
+	default:
+		return false
+	}
+}
+
+func newHTTPError(method string, path string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Message:    formatErrorBody(body),
+		Method:     method,
+		Path:       path,
+	}
+	var env apiEnvelope
+	if err := json.Unmarshal(body, &env); err == nil {
+		apiErr.Code = env.Err
+	}
+	return apiErr
+}
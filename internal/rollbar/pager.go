@@ -0,0 +1,144 @@
+package rollbar
+
+import (
+	"context"
+	"sync"
+)
+
+const DefaultPageWorkers = 4
+
+type PageFunc[T any] func(ctx context.Context, page int) ([]T, map[string]any, error)
+
+type Page[T any] struct {
+	Number int
+	Items  []T
+	Raw    map[string]any
+}
+
+type PagerOptions struct {
+	StartPage int
+	MaxPages  int
+	Workers   int
+}
+
+// Pager walks a page-numbered list endpoint in order. The first page is fetched
+// on its own; after that up to Workers pages are prefetched concurrently. The
+// first empty page is treated as the end of the list and is still returned so
+// callers can keep its raw envelope.
+type Pager[T any] struct {
+	fetch     PageFunc[T]
+	nextPage  int
+	maxPages  int
+	workers   int
+	requested int
+	buffer    []pageResult[T]
+	done      bool
+}
+
+type pageResult[T any] struct {
+	page Page[T]
+	err  error
+}
+
+func NewPager[T any](fetch PageFunc[T], opts PagerOptions) *Pager[T] {
+	start := opts.StartPage
+	if start <= 0 {
+		start = 1
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	return &Pager[T]{
+		fetch:    fetch,
+		nextPage: start,
+		maxPages: opts.MaxPages,
+		workers:  workers,
+	}
+}
+
+func (p *Pager[T]) Next(ctx context.Context) (Page[T], bool, error) {
+	if len(p.buffer) == 0 {
+		if p.done {
+			return Page[T]{}, false, nil
+		}
+		p.fill(ctx)
+		if len(p.buffer) == 0 {
+			return Page[T]{}, false, nil
+		}
+	}
+
+	result := p.buffer[0]
+	p.buffer = p.buffer[1:]
+	if result.err != nil {
+		p.buffer = nil
+		p.done = true
+		return Page[T]{}, false, result.err
+	}
+	return result.page, true, nil
+}
+
+// All collects up to maxPages pages (0 means every page) and returns the
+// combined items along with each page's raw envelope.
+func (p *Pager[T]) All(ctx context.Context, maxPages int) ([]T, []map[string]any, error) {
+	if maxPages > 0 && (p.maxPages <= 0 || maxPages < p.maxPages) {
+		p.maxPages = maxPages
+	}
+
+	items := make([]T, 0)
+	rawPages := make([]map[string]any, 0)
+	for {
+		page, ok, err := p.Next(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			return items, rawPages, nil
+		}
+		items = append(items, page.Items...)
+		rawPages = append(rawPages, page.Raw)
+	}
+}
+
+func (p *Pager[T]) fill(ctx context.Context) {
+	batch := p.workers
+	if p.requested == 0 {
+		batch = 1
+	}
+	if p.maxPages > 0 {
+		batch = min(batch, p.maxPages-p.requested)
+	}
+	if batch <= 0 {
+		p.done = true
+		return
+	}
+
+	results := make([]pageResult[T], batch)
+	var wg sync.WaitGroup
+	for i := 0; i < batch; i++ {
+		number := p.nextPage + i
+		wg.Add(1)
+		go func(i int, number int) {
+			defer wg.Done()
+			items, raw, err := p.fetch(ctx, number)
+			results[i] = pageResult[T]{
+				page: Page[T]{Number: number, Items: items, Raw: raw},
+				err:  err,
+			}
+		}(i, number)
+	}
+	wg.Wait()
+
+	p.nextPage += batch
+	p.requested += batch
+	for _, result := range results {
+		p.buffer = append(p.buffer, result)
+		if result.err != nil || len(result.page.Items) == 0 {
+			p.done = true
+			return
+		}
+	}
+	if p.maxPages > 0 && p.requested >= p.maxPages {
+		p.done = true
+	}
+}
@@ -0,0 +1,125 @@
+package rollbar
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestPagerStopsAtFirstEmptyPage(t *testing.T) {
+	var mu sync.Mutex
+	var fetched []int
+	fetch := func(ctx context.Context, page int) ([]int, map[string]any, error) {
+		mu.Lock()
+		fetched = append(fetched, page)
+		mu.Unlock()
+		if page > 3 {
+			return nil, map[string]any{"page": page}, nil
+		}
+		return []int{page * 10, page*10 + 1}, map[string]any{"page": page}, nil
+	}
+
+	items, rawPages, err := NewPager(fetch, PagerOptions{Workers: 4}).All(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []int{10, 11, 20, 21, 30, 31}
+	if len(items) != len(want) {
+		t.Fatalf("unexpected items: %#v", items)
+	}
+	for i := range want {
+		if items[i] != want[i] {
+			t.Fatalf("items out of order: %#v", items)
+		}
+	}
+	// Pages 1-3 plus the empty page 4 that marks the end.
+	if len(rawPages) != 4 || rawPages[3]["page"] != 4 {
+		t.Fatalf("unexpected raw pages: %#v", rawPages)
+	}
+	if len(fetched) != 5 {
+		t.Fatalf("expected page 1 then a window of 4, fetched %#v", fetched)
+	}
+}
+
+func TestPagerHonoursStartAndMaxPages(t *testing.T) {
+	var mu sync.Mutex
+	fetched := map[int]bool{}
+	fetch := func(ctx context.Context, page int) ([]int, map[string]any, error) {
+		mu.Lock()
+		fetched[page] = true
+		mu.Unlock()
+		return []int{page}, nil, nil
+	}
+
+	items, _, err := NewPager(fetch, PagerOptions{StartPage: 3, MaxPages: 3, Workers: 2}).All(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 3 || items[0] != 3 || items[2] != 5 {
+		t.Fatalf("unexpected items: %#v", items)
+	}
+	if len(fetched) != 3 || !fetched[3] || !fetched[4] || !fetched[5] {
+		t.Fatalf("unexpected fetched pages: %#v", fetched)
+	}
+}
+
+func TestPagerReturnsFirstError(t *testing.T) {
+	boom := errors.New("boom")
+	fetch := func(ctx context.Context, page int) ([]int, map[string]any, error) {
+		if page == 2 {
+			return nil, nil, boom
+		}
+		return []int{page}, nil, nil
+	}
+
+	pager := NewPager(fetch, PagerOptions{Workers: 3})
+	page, ok, err := pager.Next(context.Background())
+	if err != nil || !ok || page.Number != 1 {
+		t.Fatalf("unexpected first page: %#v ok=%v err=%v", page, ok, err)
+	}
+	if _, _, err := pager.Next(context.Background()); !errors.Is(err, boom) {
+		t.Fatalf("expected boom, got %v", err)
+	}
+	if _, ok, err := pager.Next(context.Background()); ok || err != nil {
+		t.Fatalf("expected pager to be exhausted, ok=%v err=%v", ok, err)
+	}
+}
+
+func TestItemsPagerFetchesPagesConcurrently(t *testing.T) {
+	var mu sync.Mutex
+	fetched := map[string]bool{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		mu.Lock()
+		fetched[page] = true
+		mu.Unlock()
+		switch page {
+		case "1":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"items":[{"id":1,"counter":1,"title":"a"}]}}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"items":[{"id":2,"counter":2,"title":"b"}]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"err":0,"result":{"items":[]}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+	pager := client.ItemsPager(ListItemsOptions{Status: "active"}, PagerOptions{Workers: DefaultPageWorkers})
+	items, rawPages, err := pager.All(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 2 || items[0].ID != 1 || items[1].ID != 2 {
+		t.Fatalf("unexpected items: %#v", items)
+	}
+	if len(rawPages) != 3 {
+		t.Fatalf("expected 3 raw pages, got %d", len(rawPages))
+	}
+	if !fetched["1"] || !fetched["2"] || !fetched["3"] {
+		t.Fatalf("unexpected fetched pages: %#v", fetched)
+	}
+}
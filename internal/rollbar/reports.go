@@ -0,0 +1,169 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type TopActiveItem struct {
+	Item              Item    `json:"item"`
+	Occurrences       int64   `json:"occurrences"`
+	UniqueOccurrences int64   `json:"unique_occurrences"`
+	Counts            []int64 `json:"counts"`
+}
+
+type CountBucket struct {
+	Timestamp int64 `json:"timestamp"`
+	Count     int64 `json:"count"`
+}
+
+type TopActiveItemsOptions struct {
+	Hours        int
+	Environments []string
+}
+
+type OccurrenceCountsOptions struct {
+	BucketSize  int
+	Environment string
+	ItemID      int64
+}
+
+type ActivatedCountsOptions struct {
+	BucketSize  int
+	Environment string
+}
+
+type TopActiveItemsResponse struct {
+	Items []TopActiveItem
+	Raw   map[string]any
+}
+
+type CountsResponse struct {
+	Buckets []CountBucket
+	Raw     map[string]any
+}
+
+func (c *Client) TopActiveItems(ctx context.Context, opts TopActiveItemsOptions) (*TopActiveItemsResponse, error) {
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	query := url.Values{}
+	if opts.Hours > 0 {
+		query.Set("hours", strconv.Itoa(opts.Hours))
+	}
+	if environments := compactStrings(opts.Environments); len(environments) > 0 {
+		query.Set("environments", strings.Join(environments, ","))
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodGet, "/api/1/reports/top_active_items", query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawEntries []map[string]any
+	if len(resp.Envelope.Result) > 0 {
+		if err := json.Unmarshal(resp.Envelope.Result, &rawEntries); err != nil {
+			return nil, fmt.Errorf("parse top active items: %w", err)
+		}
+	}
+
+	items := make([]TopActiveItem, 0, len(rawEntries))
+	for _, entry := range rawEntries {
+		itemData := getMap(entry, "item")
+		if itemData == nil {
+			itemData = entry
+		}
+		items = append(items, TopActiveItem{
+			Item:              normalizeItemMap(itemData),
+			Occurrences:       firstInt64(itemData, "occurrences"),
+			UniqueOccurrences: firstInt64(itemData, "unique_occurrences"),
+			Counts:            int64List(entry["counts"]),
+		})
+	}
+
+	return &TopActiveItemsResponse{Items: items, Raw: resp.Raw}, nil
+}
+
+func (c *Client) OccurrenceCounts(ctx context.Context, opts OccurrenceCountsOptions) (*CountsResponse, error) {
+	query := url.Values{}
+	if opts.ItemID > 0 {
+		query.Set("item_id", strconv.FormatInt(opts.ItemID, 10))
+	}
+	return c.countsReport(ctx, "/api/1/reports/occurrence_counts", opts.BucketSize, opts.Environment, query)
+}
+
+func (c *Client) ActivatedCounts(ctx context.Context, opts ActivatedCountsOptions) (*CountsResponse, error) {
+	return c.countsReport(ctx, "/api/1/reports/activated_counts", opts.BucketSize, opts.Environment, url.Values{})
+}
+
+func (c *Client) countsReport(ctx context.Context, path string, bucketSize int, environment string, query url.Values) (*CountsResponse, error) {
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	if bucketSize > 0 {
+		query.Set("bucket_size", strconv.Itoa(bucketSize))
+	}
+	if environment = strings.TrimSpace(environment); environment != "" {
+		query.Set("environment", environment)
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodGet, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawBuckets []any
+	if len(resp.Envelope.Result) > 0 {
+		if err := json.Unmarshal(resp.Envelope.Result, &rawBuckets); err != nil {
+			return nil, fmt.Errorf("parse count buckets: %w", err)
+		}
+	}
+
+	buckets := make([]CountBucket, 0, len(rawBuckets))
+	for _, rawBucket := range rawBuckets {
+		switch t := rawBucket.(type) {
+		case []any:
+			values := int64List(t)
+			if len(values) < 2 {
+				continue
+			}
+			buckets = append(buckets, CountBucket{Timestamp: values[0], Count: values[1]})
+		case map[string]any:
+			buckets = append(buckets, CountBucket{
+				Timestamp: firstInt64(t, "timestamp", "time"),
+				Count:     firstInt64(t, "count", "value"),
+			})
+		}
+	}
+
+	return &CountsResponse{Buckets: buckets, Raw: resp.Raw}, nil
+}
+
+func int64List(v any) []int64 {
+	raw, ok := v.([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]int64, 0, len(raw))
+	for _, entry := range raw {
+		out = append(out, int64Value(entry))
+	}
+	return out
+}
+
+func compactStrings(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			out = append(out, value)
+		}
+	}
+	return out
+}
@@ -0,0 +1,74 @@
+package rollbar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTopActiveItems(t *testing.T) {
+	var gotPath, gotHours, gotEnvironments string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotHours = r.URL.Query().Get("hours")
+		gotEnvironments = r.URL.Query().Get("environments")
+		_, _ = w.Write([]byte(`{"err":0,"result":[{"item":{"id":9,"counter":12,"title":"boom","level":"error","environment":"production","occurrences":30,"unique_occurrences":4},"counts":[0,10,20]}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+	resp, err := client.TopActiveItems(context.Background(), TopActiveItemsOptions{Hours: 3, Environments: []string{"production", " ", "staging"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotPath != "/api/1/reports/top_active_items" || gotHours != "3" || gotEnvironments != "production,staging" {
+		t.Fatalf("unexpected request: path=%q hours=%q environments=%q", gotPath, gotHours, gotEnvironments)
+	}
+	if len(resp.Items) != 1 {
+		t.Fatalf("unexpected items: %#v", resp.Items)
+	}
+	got := resp.Items[0]
+	if got.Item.Counter != 12 || got.Occurrences != 30 || got.UniqueOccurrences != 4 || len(got.Counts) != 3 || got.Counts[2] != 20 {
+		t.Fatalf("unexpected item: %#v", got)
+	}
+}
+
+func TestOccurrenceAndActivatedCounts(t *testing.T) {
+	var gotPaths []string
+	var gotQueries []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPaths = append(gotPaths, r.URL.Path)
+		gotQueries = append(gotQueries, r.URL.RawQuery)
+		if r.URL.Path == "/api/1/reports/activated_counts" {
+			_, _ = w.Write([]byte(`{"err":0,"result":[{"timestamp":1700000000,"count":2}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"err":0,"result":[[1700000000,5],[1700003600,7]]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+	occurrences, err := client.OccurrenceCounts(context.Background(), OccurrenceCountsOptions{BucketSize: 3600, Environment: "production", ItemID: 9})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(occurrences.Buckets) != 2 || occurrences.Buckets[1].Timestamp != 1700003600 || occurrences.Buckets[1].Count != 7 {
+		t.Fatalf("unexpected buckets: %#v", occurrences.Buckets)
+	}
+
+	activated, err := client.ActivatedCounts(context.Background(), ActivatedCountsOptions{BucketSize: 86400})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(activated.Buckets) != 1 || activated.Buckets[0].Count != 2 {
+		t.Fatalf("unexpected buckets: %#v", activated.Buckets)
+	}
+
+	if gotPaths[0] != "/api/1/reports/occurrence_counts" || gotQueries[0] != "bucket_size=3600&environment=production&item_id=9" {
+		t.Fatalf("unexpected occurrence counts request: %s?%s", gotPaths[0], gotQueries[0])
+	}
+	if gotQueries[1] != "bucket_size=86400" {
+		t.Fatalf("unexpected activated counts query: %s", gotQueries[1])
+	}
+}
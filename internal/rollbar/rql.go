@@ -0,0 +1,315 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	RQLStatusNew       = "new"
+	RQLStatusRunning   = "running"
+	RQLStatusSuccess   = "success"
+	RQLStatusFailed    = "failed"
+	RQLStatusCancelled = "cancelled"
+	RQLStatusTimedOut  = "timed_out"
+)
+
+type RQLJob struct {
+	ID           int64  `json:"id"`
+	ProjectID    int64  `json:"project_id"`
+	QueryString  string `json:"query_string"`
+	Status       string `json:"status"`
+	JobHash      string `json:"job_hash"`
+	DateCreated  int64  `json:"date_created"`
+	DateModified int64  `json:"date_modified"`
+}
+
+// Finished reports whether the job has stopped running, successfully or not.
+func (j RQLJob) Finished() bool {
+	switch j.Status {
+	case RQLStatusSuccess, RQLStatusFailed, RQLStatusCancelled, RQLStatusTimedOut:
+		return true
+	default:
+		return false
+	}
+}
+
+type RQLResult struct {
+	JobID         int64    `json:"job_id"`
+	Columns       []string `json:"columns"`
+	Rows          [][]any  `json:"rows"`
+	RowCount      int64    `json:"row_count"`
+	ExecutionTime float64  `json:"execution_time"`
+	Errors        []string `json:"errors"`
+	Warnings      []string `json:"warnings"`
+}
+
+type CreateRQLJobOptions struct {
+	QueryString  string
+	ForceRefresh bool
+}
+
+type RQLJobResponse struct {
+	Job RQLJob
+	Raw map[string]any
+}
+
+type ListRQLJobsResponse struct {
+	Jobs []RQLJob
+	Raw  map[string]any
+}
+
+type RQLJobResultResponse struct {
+	Job    RQLJob
+	Result RQLResult
+	Raw    map[string]any
+}
+
+func (c *Client) CreateRQLJob(ctx context.Context, opts CreateRQLJobOptions) (*RQLJobResponse, error) {
+	query := strings.TrimSpace(opts.QueryString)
+	if query == "" {
+		return nil, fmt.Errorf("missing RQL query")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	body := map[string]any{
+		"query_string":  query,
+		"force_refresh": opts.ForceRefresh,
+	}
+	resp, err := c.doJSON(ctx, http.MethodPost, "/api/1/rql/jobs", nil, body)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := decodeRQLJob(resp.Envelope.Result)
+	if err != nil {
+		return nil, err
+	}
+	return &RQLJobResponse{Job: job, Raw: resp.Raw}, nil
+}
+
+func (c *Client) GetRQLJob(ctx context.Context, id int64) (*RQLJobResponse, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid RQL job id: must be > 0")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodGet, "/api/1/rql/job/"+strconv.FormatInt(id, 10), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := decodeRQLJob(resp.Envelope.Result)
+	if err != nil {
+		return nil, err
+	}
+	if job.ID == 0 {
+		job.ID = id
+	}
+	return &RQLJobResponse{Job: job, Raw: resp.Raw}, nil
+}
+
+func (c *Client) GetRQLJobResult(ctx context.Context, id int64) (*RQLJobResultResponse, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid RQL job id: must be > 0")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodGet, "/api/1/rql/job/"+strconv.FormatInt(id, 10)+"/result", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]any
+	if len(resp.Envelope.Result) > 0 {
+		if err := json.Unmarshal(resp.Envelope.Result, &result); err != nil {
+			return nil, fmt.Errorf("parse RQL job result: %w", err)
+		}
+	}
+
+	job := normalizeRQLJobMap(result)
+	if job.ID == 0 {
+		job.ID = id
+	}
+
+	resultData := getMap(result, "result")
+	if resultData == nil {
+		resultData = result
+	}
+	rqlResult := normalizeRQLResultMap(resultData)
+	if rqlResult.JobID == 0 {
+		rqlResult.JobID = job.ID
+	}
+
+	return &RQLJobResultResponse{Job: job, Result: rqlResult, Raw: resp.Raw}, nil
+}
+
+func (c *Client) ListRQLJobs(ctx context.Context, page int) (*ListRQLJobsResponse, error) {
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	query := url.Values{}
+	if page > 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodGet, "/api/1/rql/jobs", query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawJobs []json.RawMessage
+	if len(resp.Envelope.Result) > 0 {
+		if err := json.Unmarshal(resp.Envelope.Result, &rawJobs); err != nil {
+			var result struct {
+				Jobs []json.RawMessage `json:"jobs"`
+			}
+			if nestedErr := json.Unmarshal(resp.Envelope.Result, &result); nestedErr != nil {
+				return nil, fmt.Errorf("parse result.jobs: %w", err)
+			}
+			rawJobs = result.Jobs
+		}
+	}
+
+	jobs := make([]RQLJob, 0, len(rawJobs))
+	for idx, rawJob := range rawJobs {
+		job, err := decodeRQLJob(rawJob)
+		if err != nil {
+			return nil, fmt.Errorf("decode RQL job %d: %w", idx, err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	return &ListRQLJobsResponse{Jobs: jobs, Raw: resp.Raw}, nil
+}
+
+func (c *Client) RQLJobsPager(pagerOpts PagerOptions) *Pager[RQLJob] {
+	return NewPager(func(ctx context.Context, page int) ([]RQLJob, map[string]any, error) {
+		resp, err := c.ListRQLJobs(ctx, page)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Jobs, resp.Raw, nil
+	}, pagerOpts)
+}
+
+func (c *Client) CancelRQLJob(ctx context.Context, id int64) (*RQLJobResponse, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid RQL job id: must be > 0")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodPost, "/api/1/rql/job/"+strconv.FormatInt(id, 10)+"/cancel", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := decodeRQLJob(resp.Envelope.Result)
+	if err != nil {
+		return nil, err
+	}
+	if job.ID == 0 {
+		job.ID = id
+	}
+	return &RQLJobResponse{Job: job, Raw: resp.Raw}, nil
+}
+
+func decodeRQLJob(raw json.RawMessage) (RQLJob, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return RQLJob{}, nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return RQLJob{}, fmt.Errorf("parse RQL job: %w", err)
+	}
+	if nested := getMap(m, "job"); nested != nil {
+		m = nested
+	}
+	return normalizeRQLJobMap(m), nil
+}
+
+func normalizeRQLJobMap(m map[string]any) RQLJob {
+	if m == nil {
+		return RQLJob{}
+	}
+
+	return RQLJob{
+		ID:           firstInt64(m, "id", "job_id"),
+		ProjectID:    firstInt64(m, "project_id", "projectId"),
+		QueryString:  firstString(m, "query_string", "queryString"),
+		Status:       firstString(m, "status"),
+		JobHash:      firstString(m, "job_hash", "jobHash"),
+		DateCreated:  firstInt64(m, "date_created", "dateCreated"),
+		DateModified: firstInt64(m, "date_modified", "dateModified"),
+	}
+}
+
+func normalizeRQLResultMap(m map[string]any) RQLResult {
+	if m == nil {
+		return RQLResult{}
+	}
+
+	result := RQLResult{
+		JobID:    firstInt64(m, "job_id", "jobId"),
+		RowCount: firstInt64(m, "rowcount", "row_count"),
+		Errors:   stringList(m["errors"]),
+		Warnings: stringList(m["warnings"]),
+	}
+	if v, ok := m["executionTime"].(float64); ok {
+		result.ExecutionTime = v
+	}
+
+	result.Columns = stringList(m["selectionColumns"])
+	if len(result.Columns) == 0 {
+		result.Columns = stringList(m["columns"])
+	}
+
+	if rawRows, ok := m["rows"].([]any); ok {
+		result.Rows = make([][]any, 0, len(rawRows))
+		for _, rawRow := range rawRows {
+			row, ok := rawRow.([]any)
+			if !ok {
+				continue
+			}
+			result.Rows = append(result.Rows, row)
+		}
+	}
+	if result.RowCount == 0 {
+		result.RowCount = int64(len(result.Rows))
+	}
+
+	return result
+}
+
+func stringList(v any) []string {
+	raw, ok := v.([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(raw))
+	for _, entry := range raw {
+		switch t := entry.(type) {
+		case string:
+			out = append(out, t)
+		case nil:
+			continue
+		default:
+			out = append(out, fmt.Sprint(t))
+		}
+	}
+	return out
+}
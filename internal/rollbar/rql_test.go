@@ -0,0 +1,131 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateRQLJob(t *testing.T) {
+	var gotBody map[string]any
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/1/rql/jobs" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"err":0,"result":{"id":77,"project_id":42,"query_string":"SELECT 1","status":"new","job_hash":"abc","date_created":1700000000}}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+	resp, err := client.CreateRQLJob(context.Background(), CreateRQLJobOptions{QueryString: " SELECT 1 ", ForceRefresh: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotBody["query_string"] != "SELECT 1" || gotBody["force_refresh"] != true {
+		t.Fatalf("unexpected body: %#v", gotBody)
+	}
+	if resp.Job.ID != 77 || resp.Job.Status != RQLStatusNew || resp.Job.JobHash != "abc" || resp.Job.Finished() {
+		t.Fatalf("unexpected job: %#v", resp.Job)
+	}
+
+	if _, err := client.CreateRQLJob(context.Background(), CreateRQLJobOptions{}); err == nil {
+		t.Fatal("expected empty query to fail")
+	}
+}
+
+func TestGetRQLJobAndCancel(t *testing.T) {
+	var gotMethods []string
+	var gotPaths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethods = append(gotMethods, r.Method)
+		gotPaths = append(gotPaths, r.URL.Path)
+		status := "running"
+		if r.Method == http.MethodPost {
+			status = "cancelled"
+		}
+		_, _ = w.Write([]byte(`{"err":0,"result":{"id":77,"status":"` + status + `"}}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+	got, err := client.GetRQLJob(context.Background(), 77)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Job.Status != RQLStatusRunning || got.Job.Finished() {
+		t.Fatalf("unexpected job: %#v", got.Job)
+	}
+
+	cancelled, err := client.CancelRQLJob(context.Background(), 77)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cancelled.Job.Status != RQLStatusCancelled || !cancelled.Job.Finished() {
+		t.Fatalf("unexpected cancelled job: %#v", cancelled.Job)
+	}
+	if gotPaths[0] != "/api/1/rql/job/77" || gotMethods[1] != http.MethodPost || gotPaths[1] != "/api/1/rql/job/77/cancel" {
+		t.Fatalf("unexpected requests: %#v %#v", gotMethods, gotPaths)
+	}
+
+	if _, err := client.GetRQLJob(context.Background(), 0); err == nil {
+		t.Fatal("expected invalid id to fail")
+	}
+}
+
+func TestGetRQLJobResult(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/rql/job/77/result" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"err":0,"result":{"id":77,"status":"success","result":{
+			"selectionColumns":["request.url","count(*)"],
+			"rows":[["/checkout",12],["/cart",3]],
+			"rowcount":2,
+			"executionTime":0.25,
+			"errors":[],
+			"warnings":["sampled"]
+		}}}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+	resp, err := client.GetRQLJobResult(context.Background(), 77)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Job.Status != RQLStatusSuccess || resp.Result.JobID != 77 {
+		t.Fatalf("unexpected job: %#v", resp)
+	}
+	if len(resp.Result.Columns) != 2 || resp.Result.Columns[1] != "count(*)" {
+		t.Fatalf("unexpected columns: %#v", resp.Result.Columns)
+	}
+	if len(resp.Result.Rows) != 2 || resp.Result.Rows[0][0] != "/checkout" || resp.Result.RowCount != 2 {
+		t.Fatalf("unexpected rows: %#v", resp.Result.Rows)
+	}
+	if resp.Result.ExecutionTime != 0.25 || len(resp.Result.Warnings) != 1 {
+		t.Fatalf("unexpected metadata: %#v", resp.Result)
+	}
+}
+
+func TestListRQLJobs(t *testing.T) {
+	var gotPage string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPage = r.URL.Query().Get("page")
+		_, _ = w.Write([]byte(`{"err":0,"result":[{"id":1,"status":"success"},{"id":2,"status":"failed"}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "tok", BaseURL: ts.URL})
+	resp, err := client.ListRQLJobs(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotPage != "2" || len(resp.Jobs) != 2 || resp.Jobs[1].Status != RQLStatusFailed {
+		t.Fatalf("unexpected jobs: page=%q %#v", gotPage, resp.Jobs)
+	}
+}
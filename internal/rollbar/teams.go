@@ -0,0 +1,244 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var TeamAccessLevels = []string{"standard", "light", "view"}
+
+type Team struct {
+	ID          int64  `json:"id"`
+	AccountID   int64  `json:"account_id"`
+	Name        string `json:"name"`
+	AccessLevel string `json:"access_level"`
+}
+
+type ListTeamsResponse struct {
+	Teams []Team
+	Raw   map[string]any
+}
+
+type TeamResponse struct {
+	Team Team
+	Raw  map[string]any
+}
+
+type ListTeamUsersResponse struct {
+	Users []User
+	Raw   map[string]any
+}
+
+type ListTeamProjectsResponse struct {
+	Projects []Project
+	Raw      map[string]any
+}
+
+func (c *Client) ListTeams(ctx context.Context) (*ListTeamsResponse, error) {
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodGet, "/api/1/teams", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rawTeams, err := resultList(resp, "teams")
+	if err != nil {
+		return nil, err
+	}
+	teams := make([]Team, 0, len(rawTeams))
+	for _, m := range rawTeams {
+		teams = append(teams, normalizeTeamMap(m))
+	}
+	return &ListTeamsResponse{Teams: teams, Raw: resp.Raw}, nil
+}
+
+func (c *Client) GetTeam(ctx context.Context, id int64) (*TeamResponse, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid team id: must be > 0")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodGet, teamPath(id), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return teamResult(resp, id)
+}
+
+func (c *Client) CreateTeam(ctx context.Context, name string, accessLevel string) (*TeamResponse, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("missing team name")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	body := map[string]any{"name": name}
+	if accessLevel = strings.TrimSpace(accessLevel); accessLevel != "" {
+		body["access_level"] = accessLevel
+	}
+	resp, err := c.doJSON(ctx, http.MethodPost, "/api/1/teams", nil, body)
+	if err != nil {
+		return nil, err
+	}
+	return teamResult(resp, 0)
+}
+
+func (c *Client) DeleteTeam(ctx context.Context, id int64) (map[string]any, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid team id: must be > 0")
+	}
+	return c.teamMembershipRequest(ctx, http.MethodDelete, teamPath(id))
+}
+
+func (c *Client) ListTeamUsers(ctx context.Context, teamID int64) (*ListTeamUsersResponse, error) {
+	if teamID <= 0 {
+		return nil, fmt.Errorf("invalid team id: must be > 0")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodGet, teamPath(teamID)+"/users", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rawUsers, err := resultList(resp, "users")
+	if err != nil {
+		return nil, err
+	}
+	users := make([]User, 0, len(rawUsers))
+	for _, m := range rawUsers {
+		users = append(users, normalizeUserMap(m))
+	}
+	return &ListTeamUsersResponse{Users: users, Raw: resp.Raw}, nil
+}
+
+func (c *Client) AddTeamUser(ctx context.Context, teamID int64, userID int64) (map[string]any, error) {
+	if teamID <= 0 || userID <= 0 {
+		return nil, fmt.Errorf("invalid team or user id: must be > 0")
+	}
+	return c.teamMembershipRequest(ctx, http.MethodPut, teamPath(teamID)+"/user/"+strconv.FormatInt(userID, 10))
+}
+
+func (c *Client) RemoveTeamUser(ctx context.Context, teamID int64, userID int64) (map[string]any, error) {
+	if teamID <= 0 || userID <= 0 {
+		return nil, fmt.Errorf("invalid team or user id: must be > 0")
+	}
+	return c.teamMembershipRequest(ctx, http.MethodDelete, teamPath(teamID)+"/user/"+strconv.FormatInt(userID, 10))
+}
+
+func (c *Client) ListTeamProjects(ctx context.Context, teamID int64) (*ListTeamProjectsResponse, error) {
+	if teamID <= 0 {
+		return nil, fmt.Errorf("invalid team id: must be > 0")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodGet, teamPath(teamID)+"/projects", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rawProjects, err := resultList(resp, "projects")
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]Project, 0, len(rawProjects))
+	for _, m := range rawProjects {
+		projects = append(projects, normalizeProjectMap(m))
+	}
+	return &ListTeamProjectsResponse{Projects: projects, Raw: resp.Raw}, nil
+}
+
+func (c *Client) AddTeamProject(ctx context.Context, teamID int64, projectID int64) (map[string]any, error) {
+	if teamID <= 0 || projectID <= 0 {
+		return nil, fmt.Errorf("invalid team or project id: must be > 0")
+	}
+	return c.teamMembershipRequest(ctx, http.MethodPut, teamPath(teamID)+"/project/"+strconv.FormatInt(projectID, 10))
+}
+
+func (c *Client) RemoveTeamProject(ctx context.Context, teamID int64, projectID int64) (map[string]any, error) {
+	if teamID <= 0 || projectID <= 0 {
+		return nil, fmt.Errorf("invalid team or project id: must be > 0")
+	}
+	return c.teamMembershipRequest(ctx, http.MethodDelete, teamPath(teamID)+"/project/"+strconv.FormatInt(projectID, 10))
+}
+
+func (c *Client) teamMembershipRequest(ctx context.Context, method string, path string) (map[string]any, error) {
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Raw, nil
+}
+
+func teamPath(id int64) string {
+	return "/api/1/team/" + strconv.FormatInt(id, 10)
+}
+
+// resultList decodes a result that is either a bare array or an object
+// wrapping the array under key.
+func resultList(resp *apiResponse, key string) ([]map[string]any, error) {
+	if len(resp.Envelope.Result) == 0 || string(resp.Envelope.Result) == "null" {
+		return nil, nil
+	}
+
+	var list []map[string]any
+	if err := json.Unmarshal(resp.Envelope.Result, &list); err == nil {
+		return list, nil
+	}
+
+	var wrapped map[string][]map[string]any
+	if err := json.Unmarshal(resp.Envelope.Result, &wrapped); err != nil {
+		return nil, fmt.Errorf("parse result.%s: %w", key, err)
+	}
+	return wrapped[key], nil
+}
+
+func teamResult(resp *apiResponse, id int64) (*TeamResponse, error) {
+	var result map[string]any
+	if len(resp.Envelope.Result) > 0 && string(resp.Envelope.Result) != "null" {
+		if err := json.Unmarshal(resp.Envelope.Result, &result); err != nil {
+			return nil, fmt.Errorf("parse team result: %w", err)
+		}
+	}
+	if nested := getMap(result, "team"); nested != nil {
+		result = nested
+	}
+
+	team := normalizeTeamMap(result)
+	if team.ID == 0 {
+		team.ID = id
+	}
+	return &TeamResponse{Team: team, Raw: resp.Raw}, nil
+}
+
+func normalizeTeamMap(m map[string]any) Team {
+	if m == nil {
+		return Team{}
+	}
+
+	return Team{
+		ID:          firstInt64(m, "id", "team_id"),
+		AccountID:   firstInt64(m, "account_id", "accountId"),
+		Name:        firstString(m, "name"),
+		AccessLevel: firstString(m, "access_level", "accessLevel"),
+	}
+}
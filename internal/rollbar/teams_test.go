@@ -0,0 +1,105 @@
+package rollbar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTeamsAPI(t *testing.T) {
+	var gotRequests []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequests = append(gotRequests, r.Method+" "+r.URL.Path)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/1/teams":
+			_, _ = w.Write([]byte(`{"err":0,"result":[{"id":7,"account_id":3,"name":"Backend","access_level":"standard"}]}`))
+		case "POST /api/1/teams":
+			_, _ = w.Write([]byte(`{"err":0,"result":{"id":8,"account_id":3,"name":"Ops","access_level":"light"}}`))
+		case "GET /api/1/team/7/users":
+			_, _ = w.Write([]byte(`{"err":0,"result":[{"team_id":7,"user_id":21},{"team_id":7,"user_id":22}]}`))
+		case "GET /api/1/team/7/projects":
+			_, _ = w.Write([]byte(`{"err":0,"result":[{"team_id":7,"project_id":11}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"err":0,"result":null}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "account-tok", BaseURL: ts.URL})
+	ctx := context.Background()
+
+	teams, err := client.ListTeams(ctx)
+	if err != nil {
+		t.Fatalf("unexpected list error: %v", err)
+	}
+	if len(teams.Teams) != 1 || teams.Teams[0].Name != "Backend" || teams.Teams[0].AccessLevel != "standard" {
+		t.Fatalf("unexpected teams: %#v", teams.Teams)
+	}
+
+	created, err := client.CreateTeam(ctx, "Ops", "light")
+	if err != nil {
+		t.Fatalf("unexpected create error: %v", err)
+	}
+	if created.Team.ID != 8 || created.Team.AccessLevel != "light" {
+		t.Fatalf("unexpected created team: %#v", created.Team)
+	}
+
+	users, err := client.ListTeamUsers(ctx, 7)
+	if err != nil {
+		t.Fatalf("unexpected users error: %v", err)
+	}
+	if len(users.Users) != 2 || users.Users[1].ID != 22 {
+		t.Fatalf("unexpected team users: %#v", users.Users)
+	}
+
+	projects, err := client.ListTeamProjects(ctx, 7)
+	if err != nil {
+		t.Fatalf("unexpected projects error: %v", err)
+	}
+	if len(projects.Projects) != 1 || projects.Projects[0].ID != 11 {
+		t.Fatalf("unexpected team projects: %#v", projects.Projects)
+	}
+
+	if _, err := client.AddTeamUser(ctx, 7, 21); err != nil {
+		t.Fatalf("unexpected add user error: %v", err)
+	}
+	if _, err := client.RemoveTeamProject(ctx, 7, 11); err != nil {
+		t.Fatalf("unexpected remove project error: %v", err)
+	}
+	if _, err := client.DeleteTeam(ctx, 8); err != nil {
+		t.Fatalf("unexpected delete error: %v", err)
+	}
+
+	want := []string{
+		"GET /api/1/teams",
+		"POST /api/1/teams",
+		"GET /api/1/team/7/users",
+		"GET /api/1/team/7/projects",
+		"PUT /api/1/team/7/user/21",
+		"DELETE /api/1/team/7/project/11",
+		"DELETE /api/1/team/8",
+	}
+	if len(gotRequests) != len(want) {
+		t.Fatalf("unexpected requests: %#v", gotRequests)
+	}
+	for i := range want {
+		if gotRequests[i] != want[i] {
+			t.Fatalf("unexpected requests: %#v", gotRequests)
+		}
+	}
+}
+
+func TestTeamsAPIValidation(t *testing.T) {
+	client := NewClient(Config{AccessToken: "tok"})
+	if _, err := client.GetTeam(context.Background(), 0); err == nil {
+		t.Fatal("expected invalid team id error")
+	}
+	if _, err := client.AddTeamUser(context.Background(), 7, 0); err == nil {
+		t.Fatal("expected invalid user id error")
+	}
+	if _, err := client.CreateTeam(context.Background(), " ", ""); err == nil {
+		t.Fatal("expected missing name error")
+	}
+}
@@ -0,0 +1,152 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var ProjectTokenScopes = []string{"read", "write", "post_server_item", "post_client_item"}
+
+type ProjectAccessToken struct {
+	ProjectID            int64    `json:"project_id"`
+	AccessToken          string   `json:"access_token"`
+	Name                 string   `json:"name"`
+	Status               string   `json:"status"`
+	Scopes               []string `json:"scopes"`
+	RateLimitWindowSize  int64    `json:"rate_limit_window_size"`
+	RateLimitWindowCount int64    `json:"rate_limit_window_count"`
+	DateCreated          int64    `json:"date_created"`
+	DateModified         int64    `json:"date_modified"`
+}
+
+type ListProjectAccessTokensResponse struct {
+	Tokens []ProjectAccessToken
+	Raw    map[string]any
+}
+
+type ProjectAccessTokenResponse struct {
+	Token ProjectAccessToken
+	Raw   map[string]any
+}
+
+func (c *Client) ListProjectAccessTokens(ctx context.Context, projectID int64) (*ListProjectAccessTokensResponse, error) {
+	if projectID <= 0 {
+		return nil, fmt.Errorf("invalid project id: must be > 0")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodGet, projectTokensPath(projectID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawTokens []json.RawMessage
+	if len(resp.Envelope.Result) > 0 {
+		if err := json.Unmarshal(resp.Envelope.Result, &rawTokens); err != nil {
+			return nil, fmt.Errorf("parse access tokens: %w", err)
+		}
+	}
+
+	tokens := make([]ProjectAccessToken, 0, len(rawTokens))
+	for idx, rawToken := range rawTokens {
+		var m map[string]any
+		if err := json.Unmarshal(rawToken, &m); err != nil {
+			return nil, fmt.Errorf("decode access token %d: %w", idx, err)
+		}
+		token := normalizeProjectAccessTokenMap(m)
+		if token.ProjectID == 0 {
+			token.ProjectID = projectID
+		}
+		tokens = append(tokens, token)
+	}
+
+	return &ListProjectAccessTokensResponse{Tokens: tokens, Raw: resp.Raw}, nil
+}
+
+func (c *Client) CreateProjectAccessToken(ctx context.Context, projectID int64, body map[string]any) (*ProjectAccessTokenResponse, error) {
+	if projectID <= 0 {
+		return nil, fmt.Errorf("invalid project id: must be > 0")
+	}
+	if len(body) == 0 {
+		return nil, fmt.Errorf("missing access token fields")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	resp, err := c.doJSON(ctx, http.MethodPost, projectTokensPath(projectID), nil, body)
+	if err != nil {
+		return nil, err
+	}
+	return projectAccessTokenResult(resp, projectID, "")
+}
+
+func (c *Client) UpdateProjectAccessToken(ctx context.Context, projectID int64, accessToken string, body map[string]any) (*ProjectAccessTokenResponse, error) {
+	if projectID <= 0 {
+		return nil, fmt.Errorf("invalid project id: must be > 0")
+	}
+	accessToken = strings.TrimSpace(accessToken)
+	if accessToken == "" {
+		return nil, fmt.Errorf("missing project access token")
+	}
+	if len(body) == 0 {
+		return nil, fmt.Errorf("missing update fields")
+	}
+	if c.accessToken == "" {
+		return nil, fmt.Errorf("missing access token")
+	}
+
+	path := "/api/1/project/" + strconv.FormatInt(projectID, 10) + "/access_token/" + url.PathEscape(accessToken)
+	resp, err := c.doJSON(ctx, http.MethodPatch, path, nil, body)
+	if err != nil {
+		return nil, err
+	}
+	return projectAccessTokenResult(resp, projectID, accessToken)
+}
+
+func projectTokensPath(projectID int64) string {
+	return "/api/1/project/" + strconv.FormatInt(projectID, 10) + "/access_tokens"
+}
+
+func projectAccessTokenResult(resp *apiResponse, projectID int64, accessToken string) (*ProjectAccessTokenResponse, error) {
+	var result map[string]any
+	if len(resp.Envelope.Result) > 0 && string(resp.Envelope.Result) != "null" {
+		if err := json.Unmarshal(resp.Envelope.Result, &result); err != nil {
+			return nil, fmt.Errorf("parse access token result: %w", err)
+		}
+	}
+
+	token := normalizeProjectAccessTokenMap(result)
+	if token.ProjectID == 0 {
+		token.ProjectID = projectID
+	}
+	if token.AccessToken == "" {
+		token.AccessToken = accessToken
+	}
+	return &ProjectAccessTokenResponse{Token: token, Raw: resp.Raw}, nil
+}
+
+func normalizeProjectAccessTokenMap(m map[string]any) ProjectAccessToken {
+	if m == nil {
+		return ProjectAccessToken{}
+	}
+
+	return ProjectAccessToken{
+		ProjectID:            firstInt64(m, "project_id", "projectId"),
+		AccessToken:          firstString(m, "access_token", "accessToken"),
+		Name:                 firstString(m, "name"),
+		Status:               firstString(m, "status"),
+		Scopes:               stringList(m["scopes"]),
+		RateLimitWindowSize:  firstInt64(m, "rate_limit_window_size"),
+		RateLimitWindowCount: firstInt64(m, "rate_limit_window_count"),
+		DateCreated:          firstInt64(m, "date_created", "dateCreated"),
+		DateModified:         firstInt64(m, "date_modified", "dateModified"),
+	}
+}
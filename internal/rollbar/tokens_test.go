@@ -0,0 +1,75 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProjectAccessTokens(t *testing.T) {
+	var gotRequests []string
+	var gotBodies []map[string]any
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequests = append(gotRequests, r.Method+" "+r.URL.Path)
+		if r.Body != nil && r.Method != http.MethodGet {
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			gotBodies = append(gotBodies, body)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"err":0,"result":[{"project_id":42,"access_token":"old-token","name":"ci","status":"enabled","scopes":["read","write"],"rate_limit_window_size":60,"rate_limit_window_count":100}]}`))
+		case http.MethodPost:
+			_, _ = w.Write([]byte(`{"err":0,"result":{"project_id":42,"access_token":"new-token","name":"ci","status":"enabled","scopes":["read"]}}`))
+		case http.MethodPatch:
+			_, _ = w.Write([]byte(`{"err":0,"result":{}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(Config{AccessToken: "account-tok", BaseURL: ts.URL})
+	list, err := client.ListProjectAccessTokens(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("unexpected list error: %v", err)
+	}
+	if len(list.Tokens) != 1 {
+		t.Fatalf("unexpected tokens: %#v", list.Tokens)
+	}
+	token := list.Tokens[0]
+	if token.AccessToken != "old-token" || len(token.Scopes) != 2 || token.RateLimitWindowSize != 60 || token.RateLimitWindowCount != 100 {
+		t.Fatalf("unexpected token: %#v", token)
+	}
+
+	created, err := client.CreateProjectAccessToken(context.Background(), 42, map[string]any{"name": "ci", "scopes": []string{"read"}})
+	if err != nil {
+		t.Fatalf("unexpected create error: %v", err)
+	}
+	if created.Token.AccessToken != "new-token" {
+		t.Fatalf("unexpected created token: %#v", created.Token)
+	}
+
+	updated, err := client.UpdateProjectAccessToken(context.Background(), 42, "old-token", map[string]any{"status": "disabled"})
+	if err != nil {
+		t.Fatalf("unexpected update error: %v", err)
+	}
+	if updated.Token.AccessToken != "old-token" || updated.Token.ProjectID != 42 {
+		t.Fatalf("expected fallback identifiers, got %#v", updated.Token)
+	}
+
+	want := []string{
+		"GET /api/1/project/42/access_tokens",
+		"POST /api/1/project/42/access_tokens",
+		"PATCH /api/1/project/42/access_token/old-token",
+	}
+	for i := range want {
+		if gotRequests[i] != want[i] {
+			t.Fatalf("unexpected requests: %#v", gotRequests)
+		}
+	}
+	if gotBodies[1]["status"] != "disabled" {
+		t.Fatalf("unexpected update body: %#v", gotBodies[1])
+	}
+}
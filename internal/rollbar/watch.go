@@ -0,0 +1,105 @@
+package rollbar
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	WatchNew         = "new"
+	WatchReactivated = "reactivated"
+	WatchEscalated   = "escalated"
+	WatchOccurrences = "occurrences"
+)
+
+// WatchEvent is one change ItemWatcher noticed between polls. The Previous
+// fields hold the item's last known state and are only set when it was known.
+type WatchEvent struct {
+	Type                string `json:"type"`
+	ObservedAt          int64  `json:"observed_at"`
+	Item                Item   `json:"item"`
+	PreviousStatus      string `json:"previous_status,omitempty"`
+	PreviousLevel       string `json:"previous_level,omitempty"`
+	PreviousOccurrences int64  `json:"previous_occurrences,omitempty"`
+	Delta               int64  `json:"delta,omitempty"`
+}
+
+// ItemWatcher remembers the last state of every item it has been shown, so
+// an item that drops off the polled page and comes back is compared with
+// what it looked like then rather than reported as new.
+type ItemWatcher struct {
+	minDelta int64
+	started  time.Time
+	polled   bool
+	known    map[int64]Item
+}
+
+// NewItemWatcher reports occurrence jumps of at least minDelta. Items first
+// seen after started count as new.
+func NewItemWatcher(minDelta int64, started time.Time) *ItemWatcher {
+	return &ItemWatcher{minDelta: max(minDelta, 1), started: started, known: make(map[int64]Item)}
+}
+
+// Poll records items and returns what changed since the previous poll. The
+// first poll only sets the baseline and returns no events.
+func (w *ItemWatcher) Poll(items []Item, now time.Time) []WatchEvent {
+	var events []WatchEvent
+	for _, item := range items {
+		if w.polled {
+			for _, event := range w.changes(item) {
+				event.ObservedAt = now.Unix()
+				events = append(events, event)
+			}
+		}
+		w.known[item.ID] = item
+	}
+	w.polled = true
+	return events
+}
+
+func (w *ItemWatcher) changes(item Item) []WatchEvent {
+	prev, ok := w.known[item.ID]
+	if !ok {
+		// An unknown item is new if it first occurred during the watch.
+		// Older items only join the baseline: whatever changed about them
+		// happened before they were seen, so there is nothing to compare.
+		if item.FirstOccurrenceTimestamp == 0 || item.FirstOccurrenceTimestamp >= w.started.Unix() {
+			return []WatchEvent{{Type: WatchNew, Item: item}}
+		}
+		return nil
+	}
+
+	var events []WatchEvent
+	if isActive(item.Status) && !isActive(prev.Status) {
+		events = append(events, WatchEvent{Type: WatchReactivated, Item: item, PreviousStatus: prev.Status})
+	}
+	if LevelRank(item.Level) < LevelRank(prev.Level) {
+		events = append(events, WatchEvent{Type: WatchEscalated, Item: item, PreviousLevel: prev.Level})
+	}
+	if delta := item.TotalOccurrences - prev.TotalOccurrences; delta >= w.minDelta {
+		events = append(events, WatchEvent{Type: WatchOccurrences, Item: item, PreviousOccurrences: prev.TotalOccurrences, Delta: delta})
+	}
+	return events
+}
+
+func isActive(status string) bool {
+	return strings.EqualFold(status, "active")
+}
+
+// LevelRank orders Rollbar levels by severity, most severe first: critical
+// is 0 and debug is 4. Unknown levels rank after debug.
+func LevelRank(level string) int64 {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "critical":
+		return 0
+	case "error":
+		return 1
+	case "warning":
+		return 2
+	case "info":
+		return 3
+	case "debug":
+		return 4
+	}
+	return 5
+}
@@ -0,0 +1,58 @@
+package rollbar
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestItemWatcherReportsChangesSincePreviousPoll(t *testing.T) {
+	started := time.Unix(1700000000, 0)
+	old := started.Unix() - 3600
+	w := NewItemWatcher(5, started)
+
+	baseline := []Item{
+		{ID: 1, Counter: 1, Status: "active", Level: "warning", TotalOccurrences: 10, FirstOccurrenceTimestamp: old},
+		{ID: 2, Counter: 2, Status: "resolved", Level: "error", TotalOccurrences: 3, FirstOccurrenceTimestamp: old},
+		{ID: 3, Counter: 3, Status: "active", Level: "error", TotalOccurrences: 50, FirstOccurrenceTimestamp: old},
+	}
+	if events := w.Poll(baseline, started); len(events) != 0 {
+		t.Fatalf("first poll should only set the baseline, got %+v", events)
+	}
+
+	now := started.Add(time.Minute)
+	events := w.Poll([]Item{
+		{ID: 1, Counter: 1, Status: "active", Level: "error", TotalOccurrences: 15, FirstOccurrenceTimestamp: old},
+		{ID: 2, Counter: 2, Status: "active", Level: "error", TotalOccurrences: 4, FirstOccurrenceTimestamp: old},
+		{ID: 3, Counter: 3, Status: "active", Level: "error", TotalOccurrences: 54, FirstOccurrenceTimestamp: old},
+		{ID: 4, Counter: 4, Status: "active", Level: "critical", TotalOccurrences: 1, FirstOccurrenceTimestamp: now.Unix()},
+		{ID: 5, Counter: 5, Status: "active", Level: "error", FirstOccurrenceTimestamp: old, LastOccurrenceTimestamp: now.Unix()},
+		{ID: 6, Counter: 6, Status: "active", Level: "error", FirstOccurrenceTimestamp: old, LastOccurrenceTimestamp: old},
+	}, now)
+
+	var got []string
+	for _, event := range events {
+		got = append(got, event.Type+" #"+strconv.FormatInt(event.Item.Counter, 10))
+		if event.ObservedAt != now.Unix() {
+			t.Fatalf("unexpected observed_at on %+v", event)
+		}
+	}
+	// Items 5 and 6 are older items seen for the first time: they join the
+	// baseline without an event, however recently they occurred.
+	want := "escalated #1,occurrences #1,reactivated #2,new #4"
+	if joined := strings.Join(got, ","); joined != want {
+		t.Fatalf("events = %s, want %s", joined, want)
+	}
+	if events[0].PreviousLevel != "warning" || events[1].Delta != 5 || events[1].PreviousOccurrences != 10 || events[2].PreviousStatus != "resolved" {
+		t.Fatalf("unexpected event details: %+v", events[:3])
+	}
+
+	// Item 3 drops off the page and comes back; it is compared with its last
+	// known state instead of being reported as new.
+	w.Poll(nil, now)
+	events = w.Poll([]Item{{ID: 3, Counter: 3, Status: "active", Level: "error", TotalOccurrences: 60, FirstOccurrenceTimestamp: old}}, now)
+	if len(events) != 1 || events[0].Type != WatchOccurrences || events[0].Delta != 6 {
+		t.Fatalf("unexpected events for a returning item: %+v", events)
+	}
+}
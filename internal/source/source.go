@@ -0,0 +1,258 @@
+// Package source maps stack-frame filenames from Rollbar onto a local checkout
+// so frames can be shown with the code around them and git blame.
+package source
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// libraryMarkers are path fragments that identify dependency and runtime
+// frames across the languages Rollbar's SDKs report.
+var libraryMarkers = []string{
+	"node_modules/",
+	"site-packages/",
+	"dist-packages/",
+	"/gems/",
+	"vendor/",
+	"/usr/lib/",
+	"/usr/local/lib/",
+	"/lib/python",
+	"/pkg/mod/",
+	"/usr/local/go/src/",
+	"<anonymous>",
+}
+
+// Rewrite replaces a filename prefix, such as the deploy path /app/ with ./,
+// before the filename is looked up under the source root.
+type Rewrite struct {
+	From string
+	To   string
+}
+
+func ParseRewrite(spec string) (Rewrite, error) {
+	from, to, ok := strings.Cut(spec, "=")
+	if !ok || from == "" {
+		return Rewrite{}, fmt.Errorf("invalid path rewrite %q: expected FROM=TO", spec)
+	}
+	return Rewrite{From: from, To: to}, nil
+}
+
+type Line struct {
+	Number  int
+	Text    string
+	Current bool
+}
+
+type Blame struct {
+	Commit string
+	Author string
+	Time   time.Time
+}
+
+// Snippet is the local source around one frame's line.
+type Snippet struct {
+	Path  string
+	Lines []Line
+	Blame *Blame
+}
+
+type Options struct {
+	Root     string
+	Rewrites []Rewrite
+	Context  int
+	Blame    bool
+}
+
+// Resolver finds frame files under Root. It caches file contents, so one
+// resolver should be reused for every frame of a command.
+type Resolver struct {
+	opts Options
+	// root is Root made absolute with symlinks resolved, which every
+	// resolved path must stay under.
+	root  string
+	files map[string][]string
+	// gitBlame is swapped out in tests.
+	gitBlame func(path string, line int) (*Blame, error)
+}
+
+func NewResolver(opts Options) *Resolver {
+	if opts.Context < 0 {
+		opts.Context = 0
+	}
+	r := &Resolver{opts: opts, files: make(map[string][]string), gitBlame: gitBlame}
+	if opts.Root != "" {
+		r.root = realPath(opts.Root)
+	}
+	return r
+}
+
+// IsLibrary reports whether a frame filename belongs to a dependency or the
+// language runtime rather than the application.
+func IsLibrary(filename string) bool {
+	name := filepath.ToSlash(filename)
+	for _, marker := range libraryMarkers {
+		if strings.Contains(name, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve applies the first matching rewrite and then looks for the file
+// under the root, dropping leading directories until a file matches, so that
+// /srv/app/releases/42/app/models/order.rb finds app/models/order.rb.
+// Filenames come from payloads anyone with a post_client_item token can
+// send, so nothing outside the root is ever returned, even through a
+// symlink.
+func (r *Resolver) Resolve(filename string) (string, bool) {
+	name := strings.TrimSpace(filename)
+	if name == "" || r.root == "" {
+		return "", false
+	}
+	for _, rewrite := range r.opts.Rewrites {
+		if strings.HasPrefix(name, rewrite.From) {
+			name = rewrite.To + strings.TrimPrefix(name, rewrite.From)
+			break
+		}
+	}
+	if u, err := url.Parse(name); err == nil && len(u.Scheme) > 1 {
+		// https://cdn.example.com/static/app.js or webpack:///./src/app.js
+		name = u.Path
+	}
+
+	if filepath.IsAbs(name) && r.contains(name) {
+		return filepath.Clean(name), true
+	}
+	parts := strings.Split(strings.Trim(filepath.ToSlash(filepath.Clean(name)), "/"), "/")
+	for i := range parts {
+		if parts[i] == ".." {
+			continue
+		}
+		candidate := filepath.Join(append([]string{r.opts.Root}, parts[i:]...)...)
+		if r.contains(candidate) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// Snippet returns the lines around line in the local copy of filename, with
+// blame for the line itself when enabled. Blame failures, such as a file
+// outside any git repository, leave Blame nil.
+func (r *Resolver) Snippet(filename string, line int) (Snippet, bool) {
+	path, ok := r.Resolve(filename)
+	if !ok || line <= 0 {
+		return Snippet{}, false
+	}
+	lines, err := r.readLines(path)
+	if err != nil || line > len(lines) {
+		return Snippet{}, false
+	}
+
+	snippet := Snippet{Path: path}
+	start := max(1, line-r.opts.Context)
+	end := min(len(lines), line+r.opts.Context)
+	for n := start; n <= end; n++ {
+		snippet.Lines = append(snippet.Lines, Line{Number: n, Text: lines[n-1], Current: n == line})
+	}
+	if r.opts.Blame {
+		if blame, err := r.gitBlame(path, line); err == nil {
+			snippet.Blame = blame
+		}
+	}
+	return snippet, true
+}
+
+func (r *Resolver) readLines(path string) ([]string, error) {
+	if lines, ok := r.files[path]; ok {
+		return lines, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	r.files[path] = lines
+	return lines, nil
+}
+
+// contains reports whether path is a file that, with symlinks resolved, is
+// inside the root.
+func (r *Resolver) contains(path string) bool {
+	if !isFile(path) {
+		return false
+	}
+	real, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(r.root, real)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func realPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if real, err := filepath.EvalSymlinks(path); err == nil {
+		return real
+	}
+	return filepath.Clean(path)
+}
+
+func gitBlame(path string, line int) (*Blame, error) {
+	n := strconv.Itoa(line)
+	out, err := exec.Command("git", "-C", filepath.Dir(path), "blame", "--porcelain", "-L", n+","+n, "--", filepath.Base(path)).Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseBlamePorcelain(out)
+}
+
+func parseBlamePorcelain(out []byte) (*Blame, error) {
+	lines := bytes.Split(out, []byte("\n"))
+	header := strings.Fields(string(lines[0]))
+	if len(header) == 0 {
+		return nil, fmt.Errorf("empty git blame output")
+	}
+	blame := &Blame{Commit: header[0]}
+	for _, raw := range lines[1:] {
+		key, value, _ := strings.Cut(string(raw), " ")
+		switch key {
+		case "author":
+			blame.Author = value
+		case "author-time":
+			if ts, err := strconv.ParseInt(value, 10, 64); err == nil {
+				blame.Time = time.Unix(ts, 0).UTC()
+			}
+		}
+	}
+	return blame, nil
+}
+
+func isFile(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
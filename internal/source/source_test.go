@@ -0,0 +1,147 @@
+package source
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	root := t.TempDir()
+	order := filepath.Join(root, "app", "models", "order.rb")
+	bundle := filepath.Join(root, "web", "src", "cart.js")
+	writeFile(t, order, "")
+	writeFile(t, bundle, "")
+
+	r := NewResolver(Options{Root: root, Rewrites: []Rewrite{{From: "webpack:///./", To: "web/"}}})
+	tests := map[string]string{
+		"app/models/order.rb":                         order,
+		"/srv/shop/releases/42/app/models/order.rb":   order,
+		"webpack:///./src/cart.js":                    bundle,
+		"https://cdn.shop.test/web/src/cart.js?v=abc": bundle,
+		"app/models/missing.rb":                       "",
+		"":                                            "",
+	}
+	for filename, want := range tests {
+		got, ok := r.Resolve(filename)
+		if got != want || ok != (want != "") {
+			t.Errorf("Resolve(%q) = %q, %v; want %q", filename, got, ok, want)
+		}
+	}
+}
+
+func TestResolveStaysUnderRoot(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	inside := filepath.Join(root, "app", "config.py")
+	secret := filepath.Join(outside, "credentials")
+	writeFile(t, inside, "")
+	writeFile(t, secret, "")
+	writeFile(t, filepath.Join(outside, "app", "settings.py"), "")
+	if err := os.Symlink(outside, filepath.Join(root, "linked")); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+
+	r := NewResolver(Options{Root: root, Rewrites: []Rewrite{{From: "/deploy/", To: outside + "/"}}})
+	tests := map[string]string{
+		inside: inside,
+		secret: "",
+		filepath.Join(outside, "app", "config.py"):      inside,
+		filepath.Join(outside, "app", "settings.py"):    "",
+		"/deploy/credentials":                           "",
+		"linked/credentials":                            "",
+		filepath.Join(root, "linked", "credentials"):    "",
+		"../" + filepath.Base(outside) + "/credentials": "",
+	}
+	for filename, want := range tests {
+		got, ok := r.Resolve(filename)
+		if got != want || ok != (want != "") {
+			t.Errorf("Resolve(%q) = %q, %v; want %q", filename, got, ok, want)
+		}
+	}
+}
+
+func TestParseRewrite(t *testing.T) {
+	got, err := ParseRewrite("/app/=./")
+	if err != nil || got != (Rewrite{From: "/app/", To: "./"}) {
+		t.Fatalf("ParseRewrite() = %+v, %v", got, err)
+	}
+	if _, err := ParseRewrite("/app/"); err == nil {
+		t.Fatalf("expected error for a rewrite without =")
+	}
+}
+
+func TestIsLibrary(t *testing.T) {
+	for filename, want := range map[string]bool{
+		"app/models/order.rb":                                    false,
+		"/srv/app/node_modules/express/lib/router/index.js":      true,
+		"/usr/lib/python3.12/site-packages/requests/api.py":      true,
+		"/home/deploy/.gem/ruby/3.2.0/gems/rack-3.0/lib/rack.rb": true,
+		"internal/checkout/charge.go":                            false,
+	} {
+		if got := IsLibrary(filename); got != want {
+			t.Errorf("IsLibrary(%q) = %v, want %v", filename, got, want)
+		}
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "app.py"), "one\ntwo\nthree\nfour\nfive\n")
+
+	r := NewResolver(Options{Root: root, Context: 1, Blame: true})
+	r.gitBlame = func(path string, line int) (*Blame, error) {
+		return &Blame{Commit: "abc1234", Author: "Ana", Time: time.Unix(1700000000, 0).UTC()}, nil
+	}
+	got, ok := r.Snippet("/deploy/app.py", 5)
+	if !ok {
+		t.Fatalf("expected a snippet")
+	}
+	want := []Line{{Number: 4, Text: "four"}, {Number: 5, Text: "five", Current: true}}
+	if !reflect.DeepEqual(got.Lines, want) || got.Blame == nil || got.Blame.Author != "Ana" {
+		t.Fatalf("Snippet() = %+v", got)
+	}
+	if _, ok := r.Snippet("/deploy/app.py", 9); ok {
+		t.Fatalf("expected no snippet past the end of the file")
+	}
+}
+
+func TestGitBlame(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "app.py"), "one\ntwo\n")
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "app.py"},
+		{"-c", "user.name=Ana Lee", "-c", "user.email=ana@example.com", "commit", "-q", "-m", "add app"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = root
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	blame, err := gitBlame(filepath.Join(root, "app.py"), 2)
+	if err != nil {
+		t.Fatalf("gitBlame() error = %v", err)
+	}
+	if blame.Author != "Ana Lee" || len(blame.Commit) != 40 || blame.Time.IsZero() {
+		t.Fatalf("unexpected blame: %+v", blame)
+	}
+}
@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"os/exec"
+	"runtime"
+)
+
+type browserCommand struct {
+	name string
+	args []string
+}
+
+// OpenURL opens target in the default browser without waiting for it.
+func OpenURL(target string) error {
+	command := browserCommandFor(runtime.GOOS)
+	path, err := exec.LookPath(command.name)
+	if err != nil {
+		return err
+	}
+	return exec.Command(path, append(command.args, target)...).Start()
+}
+
+func browserCommandFor(goos string) browserCommand {
+	switch goos {
+	case "windows":
+		return browserCommand{name: "rundll32", args: []string{"url.dll,FileProtocolHandler"}}
+	case "darwin":
+		return browserCommand{name: "open"}
+	default:
+		return browserCommand{name: "xdg-open"}
+	}
+}
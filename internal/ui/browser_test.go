@@ -0,0 +1,15 @@
+package ui
+
+import "testing"
+
+func TestBrowserCommandFor(t *testing.T) {
+	if got := browserCommandFor("darwin"); got.name != "open" {
+		t.Fatalf("unexpected darwin browser command: %#v", got)
+	}
+	if got := browserCommandFor("windows"); got.name != "rundll32" || len(got.args) != 1 {
+		t.Fatalf("unexpected windows browser command: %#v", got)
+	}
+	if got := browserCommandFor("linux"); got.name != "xdg-open" {
+		t.Fatalf("unexpected linux browser command: %#v", got)
+	}
+}
@@ -16,6 +16,8 @@ type EnvironmentRenderOptions struct {
 	NoHeaders bool
 }
 
+var defaultEnvironmentListFields = []string{"id", "project_id", "environment"}
+
 func RenderEnvironments(environments []rollbar.Environment) error {
 	return RenderEnvironmentsWithOptions(environments, EnvironmentRenderOptions{})
 }
@@ -31,7 +33,7 @@ func RenderEnvironmentsWithOptions(environments []rollbar.Environment, opts Envi
 func renderEnvironmentsPlain(w io.Writer, environments []rollbar.Environment, opts EnvironmentRenderOptions) error {
 	fields := opts.Fields
 	if len(fields) == 0 {
-		fields = []string{"id", "project_id", "environment"}
+		fields = defaultEnvironmentListFields
 	}
 	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
 	if !opts.NoHeaders {
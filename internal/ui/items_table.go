@@ -34,13 +34,16 @@ type PayloadRenderOptions struct {
 
 type ItemDetailsRenderOptions struct {
 	Payload PayloadRenderOptions
+	Source  SourceRenderOptions
 }
 
 type ItemListInteractions struct {
 	FetchOccurrences func(item rollbar.Item) ([]rollbar.ItemInstance, error)
 	ResolveItem      func(item rollbar.Item) (rollbar.Item, error)
 	MuteItem         func(item rollbar.Item) (rollbar.Item, error)
+	AssignItem       func(item rollbar.Item, user string) (rollbar.Item, error)
 	CopyItemID       func(item rollbar.Item) error
+	OpenItem         func(item rollbar.Item) (string, error)
 	Payload          PayloadRenderOptions
 }
 
@@ -51,6 +54,8 @@ type model struct {
 	showOccurrences bool
 	occurrences     []rollbar.ItemInstance
 	statusMessage   string
+	assigning       bool
+	assignInput     string
 	interactions    *ItemListInteractions
 	lookPath        func(string) (string, error)
 	command         func(string, ...string) *exec.Cmd
@@ -95,7 +100,7 @@ func RenderItemWithInstancesOptions(item rollbar.Item, instances []rollbar.ItemI
 	if err := renderItemWithOptions(os.Stdout, item, opts); err != nil {
 		return err
 	}
-	return renderItemInstances(os.Stdout, instances, opts.Payload)
+	return renderItemInstances(os.Stdout, instances, opts.Payload, opts.Source)
 }
 
 func renderItem(w io.Writer, item rollbar.Item) error {
@@ -127,10 +132,44 @@ func renderItemWithOptions(w io.Writer, item rollbar.Item, _ ItemDetailsRenderOp
 	if _, err := fmt.Fprintf(w, "Last Seen: %s\n", formatUnix(item.LastOccurrenceTimestamp)); err != nil {
 		return err
 	}
+	for _, line := range optionalItemDetails(item) {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func renderItemInstances(w io.Writer, instances []rollbar.ItemInstance, payloadOpts PayloadRenderOptions) error {
+// optionalItemDetails lists the item fields that are only shown when the API
+// returned them.
+func optionalItemDetails(item rollbar.Item) []string {
+	var lines []string
+	add := func(label, value string) {
+		if value != "" && value != "0" {
+			lines = append(lines, label+": "+value)
+		}
+	}
+	if item.FirstOccurrenceTimestamp > 0 {
+		add("First Seen", formatUnix(item.FirstOccurrenceTimestamp))
+	}
+	add("Unique Occurrences", strconv.FormatInt(item.UniqueOccurrences, 10))
+	add("First Occurrence ID", strconv.FormatInt(item.FirstOccurrenceID, 10))
+	add("Activating Occurrence ID", strconv.FormatInt(item.ActivatingOccurrenceID, 10))
+	add("Assigned User ID", strconv.FormatInt(item.AssignedUserID, 10))
+	add("Assigned Team ID", strconv.FormatInt(item.AssignedTeamID, 10))
+	add("Resolved In Version", item.ResolvedInVersion)
+	if item.SnoozeEnabled {
+		lines = append(lines, "Snoozed Until: "+formatUnix(item.SnoozeExpiresAt))
+	}
+	add("Framework", item.Framework)
+	add("Platform", item.Platform)
+	add("Language", item.Language)
+	add("Project ID", strconv.FormatInt(item.ProjectID, 10))
+	add("Hash", item.Hash)
+	return lines
+}
+
+func renderItemInstances(w io.Writer, instances []rollbar.ItemInstance, payloadOpts PayloadRenderOptions, sourceOpts SourceRenderOptions) error {
 	if _, err := fmt.Fprintf(w, "Instances: %d\n", len(instances)); err != nil {
 		return err
 	}
@@ -163,12 +202,13 @@ func renderItemInstances(w io.Writer, instances []rollbar.ItemInstance, payloadO
 			}
 		}
 		for _, frame := range instance.StackFrames {
-			location := fallback(frame.Filename)
-			if frame.Line > 0 {
-				location = fmt.Sprintf("%s:%d", location, frame.Line)
+			if sourceOpts.Resolver != nil {
+				if err := renderFrameSource(w, frame, sourceOpts); err != nil {
+					return err
+				}
+				continue
 			}
-			method := fallback(frame.Method)
-			if _, err := fmt.Fprintf(w, "    %s (%s)\n", location, method); err != nil {
+			if _, err := fmt.Fprintf(w, "    %s\n", formatStackFrame(frame)); err != nil {
 				return err
 			}
 		}
@@ -200,6 +240,14 @@ func renderItemInstances(w io.Writer, instances []rollbar.ItemInstance, payloadO
 	return nil
 }
 
+func formatStackFrame(frame rollbar.StackFrame) string {
+	location := fallback(frame.Filename)
+	if frame.Line > 0 {
+		location = fmt.Sprintf("%s:%d", location, frame.Line)
+	}
+	return fmt.Sprintf("%s (%s)", location, fallback(frame.Method))
+}
+
 func renderItemsTUI(items []rollbar.Item, opts ItemListRenderOptions) error {
 	columns := []table.Column{
 		{Title: "ID", Width: 10},
@@ -271,6 +319,9 @@ func (m model) Init() tea.Cmd {
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.assigning {
+			return m.updateAssignPrompt(msg)
+		}
 		switch msg.String() {
 		case "ctrl+c", "q", "esc":
 			return m, tea.Quit
@@ -311,6 +362,21 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.statusMessage = fmt.Sprintf("copied item id %d", item.ID)
 			}
 			return m, nil
+		case "O":
+			item, ok := m.selectedItem()
+			if !ok {
+				return m, nil
+			}
+			if m.interactions == nil || m.interactions.OpenItem == nil {
+				m.statusMessage = "open unavailable"
+				return m, nil
+			}
+			if target, err := m.interactions.OpenItem(item); err != nil {
+				m.statusMessage = fmt.Sprintf("open failed: %v", err)
+			} else {
+				m.statusMessage = "opened " + target
+			}
+			return m, nil
 		case "r":
 			return m.applyUpdate(func(item rollbar.Item) (rollbar.Item, error) {
 				if m.interactions == nil || m.interactions.ResolveItem == nil {
@@ -325,6 +391,18 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m.interactions.MuteItem(item)
 			}, "muted")
+		case "a":
+			if _, ok := m.selectedItem(); !ok {
+				return m, nil
+			}
+			if m.interactions == nil || m.interactions.AssignItem == nil {
+				m.statusMessage = "assign unavailable"
+				return m, nil
+			}
+			m.assigning = true
+			m.assignInput = ""
+			m.statusMessage = ""
+			return m, nil
 		}
 	case tea.WindowSizeMsg:
 		m.table.SetWidth(msg.Width - 2)
@@ -336,8 +414,43 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// updateAssignPrompt collects a username or email for the "a" action while
+// the prompt is open, so table navigation keys are typed instead of handled.
+func (m model) updateAssignPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.assigning = false
+		m.statusMessage = "assign cancelled"
+		return m, nil
+	case tea.KeyEnter:
+		user := strings.TrimSpace(m.assignInput)
+		m.assigning = false
+		if user == "" {
+			m.statusMessage = "assign cancelled"
+			return m, nil
+		}
+		return m.applyUpdate(func(item rollbar.Item) (rollbar.Item, error) {
+			return m.interactions.AssignItem(item, user)
+		}, "assigned to "+user)
+	case tea.KeyBackspace:
+		if runes := []rune(m.assignInput); len(runes) > 0 {
+			m.assignInput = string(runes[:len(runes)-1])
+		}
+		return m, nil
+	case tea.KeySpace:
+		m.assignInput += " "
+		return m, nil
+	case tea.KeyRunes:
+		m.assignInput += string(msg.Runes)
+		return m, nil
+	}
+	return m, nil
+}
+
 func (m model) View() string {
-	help := lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render("↑/↓ navigate • enter occurrences • o details • y copy id • r resolve • m mute • q quit")
+	help := lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render("↑/↓ navigate • enter occurrences • o details • O open in browser • y copy id • r resolve • m mute • a assign • q quit")
 	view := "\n" + m.table.View() + "\n"
 	if m.showDetails {
 		view += m.detailsView() + "\n"
@@ -345,6 +458,9 @@ func (m model) View() string {
 	if m.showOccurrences {
 		view += m.occurrencesView() + "\n"
 	}
+	if m.assigning {
+		view += "Assign to user (username or email): " + m.assignInput + "█\n"
+	}
 	if m.statusMessage != "" {
 		view += lipgloss.NewStyle().Foreground(lipgloss.Color("245")).Render(m.statusMessage) + "\n"
 	}
@@ -367,6 +483,7 @@ func (m model) detailsView() string {
 		fmt.Sprintf("Occurrences: %d", item.TotalOccurrences),
 		fmt.Sprintf("Title: %s", fallback(item.Title)),
 	}
+	details = append(details, optionalItemDetails(item)...)
 	box := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(lipgloss.Color("240")).
@@ -424,6 +541,14 @@ func fallback(v string) string {
 	return v
 }
 
+// formatID renders an optional ID, using "-" when it is unset.
+func formatID(id int64) string {
+	if id <= 0 {
+		return "-"
+	}
+	return strconv.FormatInt(id, 10)
+}
+
 func indentLines(v string, prefix string) string {
 	if v == "" {
 		return prefix
@@ -565,6 +690,34 @@ func itemFieldValues(item rollbar.Item, fields []string) []string {
 			values = append(values, fallback(item.Title))
 		case "total_occurrences":
 			values = append(values, strconv.FormatInt(item.TotalOccurrences, 10))
+		case "unique_occurrences":
+			values = append(values, strconv.FormatInt(item.UniqueOccurrences, 10))
+		case "first_seen":
+			values = append(values, formatUnix(item.FirstOccurrenceTimestamp))
+		case "first_occurrence_id":
+			values = append(values, formatID(item.FirstOccurrenceID))
+		case "activating_occurrence_id":
+			values = append(values, formatID(item.ActivatingOccurrenceID))
+		case "project_id":
+			values = append(values, formatID(item.ProjectID))
+		case "hash":
+			values = append(values, fallback(item.Hash))
+		case "assigned_user_id":
+			values = append(values, formatID(item.AssignedUserID))
+		case "assigned_team_id":
+			values = append(values, formatID(item.AssignedTeamID))
+		case "resolved_in_version":
+			values = append(values, fallback(item.ResolvedInVersion))
+		case "framework":
+			values = append(values, fallback(item.Framework))
+		case "platform":
+			values = append(values, fallback(item.Platform))
+		case "language":
+			values = append(values, fallback(item.Language))
+		case "snoozed":
+			values = append(values, strconv.FormatBool(item.SnoozeEnabled))
+		case "snooze_expires":
+			values = append(values, formatUnix(item.SnoozeExpiresAt))
 		default:
 			values = append(values, "-")
 		}
@@ -84,6 +84,33 @@ func TestRenderItem(t *testing.T) {
 	}
 }
 
+func TestRenderItemRichFields(t *testing.T) {
+	item := rollbar.Item{
+		ID:                       12,
+		FirstOccurrenceTimestamp: 1700000000,
+		AssignedUserID:           321,
+		ResolvedInVersion:        "v1.2",
+		Framework:                "django",
+	}
+	out := captureStdout(t, func() {
+		_ = RenderItem(item)
+	})
+	for _, line := range []string{"First Seen: 2023-11-14T22:13:20Z", "Assigned User ID: 321", "Resolved In Version: v1.2", "Framework: django"} {
+		if !strings.Contains(out, line) {
+			t.Fatalf("missing %q in output: %q", line, out)
+		}
+	}
+	if strings.Contains(out, "Assigned Team ID") || strings.Contains(out, "Snoozed") {
+		t.Fatalf("expected unset fields to be hidden: %q", out)
+	}
+
+	values := itemFieldValues(item, []string{"first_seen", "assigned_user_id", "assigned_team_id", "framework", "snoozed"})
+	want := []string{"2023-11-14T22:13:20Z", "321", "-", "django", "false"}
+	if strings.Join(values, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected field values: %#v", values)
+	}
+}
+
 func TestRenderItemWithInstances(t *testing.T) {
 	out := captureStdout(t, func() {
 		_ = RenderItemWithInstancesOptions(
@@ -216,6 +243,70 @@ func TestModelCopyAndResolveActions(t *testing.T) {
 	}
 }
 
+func TestModelAssignPrompt(t *testing.T) {
+	var gotUser string
+	m := newTestModel()
+	m.interactions = &ItemListInteractions{
+		AssignItem: func(item rollbar.Item, user string) (rollbar.Item, error) {
+			gotUser = user
+			return item, nil
+		},
+	}
+
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	updated := next.(model)
+	if !updated.assigning {
+		t.Fatalf("expected assign prompt to open")
+	}
+	for _, msg := range []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("alx")},
+		{Type: tea.KeyBackspace},
+		{Type: tea.KeyRunes, Runes: []rune("ice")},
+	} {
+		next, _ = updated.Update(msg)
+		updated = next.(model)
+	}
+	if !strings.Contains(updated.View(), "alice") {
+		t.Fatalf("expected prompt input in view: %q", updated.View())
+	}
+
+	next, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated = next.(model)
+	if updated.assigning || gotUser != "alice" {
+		t.Fatalf("expected assignment to alice, got assigning=%v user=%q", updated.assigning, gotUser)
+	}
+	if !strings.Contains(updated.statusMessage, "assigned to alice") {
+		t.Fatalf("unexpected status: %q", updated.statusMessage)
+	}
+
+	next, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	next, _ = next.(model).Update(tea.KeyMsg{Type: tea.KeyEsc})
+	updated = next.(model)
+	if updated.assigning || updated.statusMessage != "assign cancelled" {
+		t.Fatalf("expected cancelled prompt, got %#v", updated.statusMessage)
+	}
+}
+
+func TestModelOpenItem(t *testing.T) {
+	m := newTestModel()
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'O'}})
+	if got := next.(model).statusMessage; got != "open unavailable" {
+		t.Fatalf("unexpected status without interaction: %q", got)
+	}
+
+	var opened int64
+	m.interactions = &ItemListInteractions{
+		OpenItem: func(item rollbar.Item) (string, error) {
+			opened = item.Counter
+			return "https://rollbar.com/acme/api/items/34/", nil
+		},
+	}
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'O'}})
+	if got := next.(model).statusMessage; opened != 34 || got != "opened https://rollbar.com/acme/api/items/34/" {
+		t.Fatalf("unexpected open: counter=%d status=%q", opened, got)
+	}
+}
+
 func TestClipboardCommands(t *testing.T) {
 	if got := clipboardCommands("darwin"); len(got) != 1 || got[0].name != "pbcopy" {
 		t.Fatalf("unexpected darwin clipboard commands: %#v", got)
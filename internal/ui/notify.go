@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+type notifyCommand struct {
+	name string
+	args []string
+}
+
+// Notify shows a desktop notification.
+func Notify(title, message string) error {
+	command, err := notifyCommandFor(runtime.GOOS, title, message)
+	if err != nil {
+		return err
+	}
+	path, err := exec.LookPath(command.name)
+	if err != nil {
+		return err
+	}
+	return exec.Command(path, command.args...).Run()
+}
+
+func notifyCommandFor(goos, title, message string) (notifyCommand, error) {
+	switch goos {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(message), appleScriptString(title))
+		return notifyCommand{name: "osascript", args: []string{"-e", script}}, nil
+	case "windows":
+		return notifyCommand{}, fmt.Errorf("desktop notifications are not supported on %s", goos)
+	default:
+		return notifyCommand{name: "notify-send", args: []string{"--app-name", "rollbar-cli", title, message}}, nil
+	}
+}
+
+func appleScriptString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
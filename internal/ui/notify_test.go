@@ -0,0 +1,16 @@
+package ui
+
+import "testing"
+
+func TestNotifyCommandFor(t *testing.T) {
+	got, err := notifyCommandFor("darwin", `Rollbar "#4"`, "boom")
+	if err != nil || got.name != "osascript" || got.args[1] != `display notification "boom" with title "Rollbar \"#4\""` {
+		t.Fatalf("unexpected darwin notify command: %#v, %v", got, err)
+	}
+	if got, err := notifyCommandFor("linux", "Rollbar", "boom"); err != nil || got.name != "notify-send" || got.args[len(got.args)-1] != "boom" {
+		t.Fatalf("unexpected linux notify command: %#v, %v", got, err)
+	}
+	if _, err := notifyCommandFor("windows", "Rollbar", "boom"); err == nil {
+		t.Fatal("expected windows notifications to be unsupported")
+	}
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+const (
+	ansiRed   = "\x1b[31m"
+	ansiGreen = "\x1b[32m"
+	ansiCyan  = "\x1b[36m"
+	ansiReset = "\x1b[0m"
+)
+
+type OccurrenceDiffRenderOptions struct {
+	Color bool
+}
+
+func RenderOccurrenceDiff(before, after rollbar.ItemInstance, payload []rollbar.PayloadChange, frames []rollbar.FrameChange, opts OccurrenceDiffRenderOptions) error {
+	return renderOccurrenceDiff(os.Stdout, before, after, payload, frames, opts)
+}
+
+func renderOccurrenceDiff(w io.Writer, before, after rollbar.ItemInstance, payload []rollbar.PayloadChange, frames []rollbar.FrameChange, opts OccurrenceDiffRenderOptions) error {
+	paint := func(color, text string) string {
+		if !opts.Color {
+			return text
+		}
+		return color + text + ansiReset
+	}
+
+	lines := []string{
+		paint(ansiRed, "--- "+occurrenceDiffLabel(before)),
+		paint(ansiGreen, "+++ "+occurrenceDiffLabel(after)),
+		"",
+		"Payload:",
+	}
+	if len(payload) == 0 {
+		lines = append(lines, "  no differences")
+	}
+	for _, change := range payload {
+		switch change.Change {
+		case rollbar.DiffAdded:
+			lines = append(lines, paint(ansiGreen, "+ "+change.Path+": "+diffValueText(change.After)))
+		case rollbar.DiffRemoved:
+			lines = append(lines, paint(ansiRed, "- "+change.Path+": "+diffValueText(change.Before)))
+		default:
+			lines = append(lines,
+				paint(ansiCyan, "~ "+change.Path),
+				paint(ansiRed, "    - "+diffValueText(change.Before)),
+				paint(ansiGreen, "    + "+diffValueText(change.After)),
+			)
+		}
+	}
+
+	lines = append(lines, "", "Stack Frames:")
+	if len(frames) == 0 {
+		lines = append(lines, "  -")
+	}
+	changed := false
+	for _, change := range frames {
+		switch change.Change {
+		case rollbar.DiffAdded:
+			changed = true
+			lines = append(lines, paint(ansiGreen, "+ "+formatStackFrame(change.Frame)))
+		case rollbar.DiffRemoved:
+			changed = true
+			lines = append(lines, paint(ansiRed, "- "+formatStackFrame(change.Frame)))
+		default:
+			lines = append(lines, "  "+formatStackFrame(change.Frame))
+		}
+	}
+	if len(frames) > 0 && !changed {
+		lines = append(lines, "  no differences")
+	}
+
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func occurrenceDiffLabel(instance rollbar.ItemInstance) string {
+	return fmt.Sprintf("occurrence %d  %s  %s", instance.ID, fallback(instance.UUID), formatUnix(instance.Timestamp))
+}
+
+func diffValueText(value any) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return fmt.Sprint(value)
+	}
+	return truncateString(string(bytes.TrimSpace(buf.Bytes())), 200)
+}
@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+func TestRenderOccurrenceDiff(t *testing.T) {
+	before := rollbar.ItemInstance{ID: 501, UUID: "inst-a", Timestamp: 1700000000}
+	after := rollbar.ItemInstance{ID: 502, UUID: "inst-b", Timestamp: 1700003600}
+	payload := []rollbar.PayloadChange{
+		{Path: "person.id", Change: rollbar.DiffAdded, After: float64(42)},
+		{Path: "request.url", Change: rollbar.DiffChanged, Before: "/a", After: "/b"},
+	}
+	frames := []rollbar.FrameChange{
+		{Change: rollbar.DiffSame, Frame: rollbar.StackFrame{Filename: "app.py", Line: 10, Method: "handler"}},
+		{Change: rollbar.DiffRemoved, Frame: rollbar.StackFrame{Filename: "app.py", Line: 20, Method: "charge"}},
+	}
+
+	var buf bytes.Buffer
+	if err := renderOccurrenceDiff(&buf, before, after, payload, frames, OccurrenceDiffRenderOptions{}); err != nil {
+		t.Fatalf("renderOccurrenceDiff() error = %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"--- occurrence 501  inst-a  2023-11-14T22:13:20Z",
+		"+ person.id: 42",
+		"~ request.url\n    - \"/a\"\n    + \"/b\"",
+		"  app.py:10 (handler)\n- app.py:20 (charge)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("missing %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "\x1b[") {
+		t.Fatalf("expected no color codes, got %q", out)
+	}
+
+	buf.Reset()
+	if err := renderOccurrenceDiff(&buf, before, after, payload, frames, OccurrenceDiffRenderOptions{Color: true}); err != nil {
+		t.Fatalf("renderOccurrenceDiff() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), ansiGreen+"+ person.id: 42"+ansiReset) {
+		t.Fatalf("expected colored added line, got %q", buf.String())
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
 )
 
+var defaultOccurrenceListFields = []string{"id", "uuid", "level", "environment", "timestamp", "stack_frames"}
+
 func RenderOccurrences(occurrences []rollbar.ItemInstance) error {
 	return RenderOccurrencesWithOptions(occurrences, OccurrenceRenderOptions{})
 }
@@ -19,6 +21,7 @@ type OccurrenceRenderOptions struct {
 	Fields    []string
 	NoHeaders bool
 	Payload   PayloadRenderOptions
+	Source    SourceRenderOptions
 }
 
 func RenderOccurrencesWithOptions(occurrences []rollbar.ItemInstance, opts OccurrenceRenderOptions) error {
@@ -34,13 +37,13 @@ func RenderOccurrence(occurrence rollbar.ItemInstance) error {
 }
 
 func RenderOccurrenceWithOptions(occurrence rollbar.ItemInstance, opts OccurrenceRenderOptions) error {
-	return renderItemInstances(os.Stdout, []rollbar.ItemInstance{occurrence}, opts.Payload)
+	return renderItemInstances(os.Stdout, []rollbar.ItemInstance{occurrence}, opts.Payload, opts.Source)
 }
 
 func renderOccurrencesPlain(w io.Writer, occurrences []rollbar.ItemInstance, opts OccurrenceRenderOptions) error {
 	fields := opts.Fields
 	if len(fields) == 0 {
-		fields = []string{"id", "uuid", "level", "environment", "timestamp", "stack_frames"}
+		fields = defaultOccurrenceListFields
 	}
 	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
 	if !opts.NoHeaders {
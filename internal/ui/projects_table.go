@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+type ProjectRenderOptions struct {
+	Fields    []string
+	NoHeaders bool
+}
+
+var defaultProjectListFields = []string{"id", "name", "status", "date_created"}
+
+func RenderProject(project rollbar.Project) error {
+	return renderProject(os.Stdout, project)
+}
+
+func RenderProjects(projects []rollbar.Project) error {
+	return RenderProjectsWithOptions(projects, ProjectRenderOptions{})
+}
+
+func RenderProjectsWithOptions(projects []rollbar.Project, opts ProjectRenderOptions) error {
+	if len(projects) == 0 {
+		_, err := fmt.Fprintln(os.Stdout, "No projects found.")
+		return err
+	}
+	return renderProjectsPlain(os.Stdout, projects, opts)
+}
+
+func DefaultProjectListFields() []string {
+	return append([]string(nil), defaultProjectListFields...)
+}
+
+func renderProject(w io.Writer, project rollbar.Project) error {
+	if _, err := fmt.Fprintf(w, "ID: %d\n", project.ID); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Account ID: %d\n", project.AccountID); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Name: %s\n", fallback(project.Name)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Status: %s\n", fallback(project.Status)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Created: %s\n", formatUnix(project.DateCreated)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Modified: %s\n", formatUnix(project.DateModified)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func renderProjectsPlain(w io.Writer, projects []rollbar.Project, opts ProjectRenderOptions) error {
+	fields := opts.Fields
+	if len(fields) == 0 {
+		fields = defaultProjectListFields
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if !opts.NoHeaders {
+		if _, err := fmt.Fprintln(tw, strings.Join(fieldHeaders(fields), "\t")); err != nil {
+			return err
+		}
+	}
+	for _, project := range projects {
+		if _, err := fmt.Fprintln(tw, strings.Join(projectFieldValues(project, fields), "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func projectFieldValues(project rollbar.Project, fields []string) []string {
+	values := make([]string, 0, len(fields))
+	for _, field := range fields {
+		switch field {
+		case "id", "project_id":
+			values = append(values, strconv.FormatInt(project.ID, 10))
+		case "account_id":
+			values = append(values, strconv.FormatInt(project.AccountID, 10))
+		case "name":
+			values = append(values, fallback(project.Name))
+		case "status":
+			values = append(values, fallback(project.Status))
+		case "date_created":
+			values = append(values, formatUnix(project.DateCreated))
+		case "date_modified":
+			values = append(values, formatUnix(project.DateModified))
+		default:
+			values = append(values, "-")
+		}
+	}
+	return values
+}
@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+func TestRenderProjectsPlain(t *testing.T) {
+	var buf bytes.Buffer
+	err := renderProjectsPlain(&buf, []rollbar.Project{
+		{ID: 11, AccountID: 3, Name: "web", Status: "enabled"},
+	}, ProjectRenderOptions{Fields: []string{"id", "account_id", "name"}})
+	if err != nil {
+		t.Fatalf("renderProjectsPlain() error = %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "ACCOUNT_ID") || !strings.Contains(out, "web") || strings.Contains(out, "enabled") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestRenderProjectsEmpty(t *testing.T) {
+	out := captureStdout(t, func() {
+		if err := RenderProjects(nil); err != nil {
+			t.Fatalf("RenderProjects() error = %v", err)
+		}
+	})
+	if !strings.Contains(out, "No projects found") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
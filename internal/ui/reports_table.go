@@ -0,0 +1,208 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+const defaultReportBarWidth = 40
+
+var sparkRunes = []rune("▁▂▃▄▅▆▇█")
+
+type ReportRenderOptions struct {
+	NoHeaders bool
+	BarWidth  int
+}
+
+func RenderTopActiveItems(items []rollbar.TopActiveItem, opts ReportRenderOptions) error {
+	if len(items) == 0 {
+		_, err := fmt.Fprintln(os.Stdout, "No active items found.")
+		return err
+	}
+	return renderTopActiveItems(os.Stdout, items, opts)
+}
+
+func RenderCountBuckets(buckets []rollbar.CountBucket, opts ReportRenderOptions) error {
+	if len(buckets) == 0 {
+		_, err := fmt.Fprintln(os.Stdout, "No counts found.")
+		return err
+	}
+	return renderCountBuckets(os.Stdout, buckets, opts)
+}
+
+func RenderBreakdowns(breakdowns []rollbar.Breakdown, opts ReportRenderOptions) error {
+	if len(breakdowns) == 0 || breakdowns[0].Total == 0 {
+		_, err := fmt.Fprintln(os.Stdout, "No occurrences found.")
+		return err
+	}
+	return renderBreakdowns(os.Stdout, breakdowns, opts)
+}
+
+// Sparkline scales values onto eight block characters. A zero always renders
+// as the lowest block so quiet buckets stay visible next to busy ones.
+func Sparkline(values []int64) string {
+	var peak int64
+	for _, v := range values {
+		if v > peak {
+			peak = v
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		if v <= 0 || peak == 0 {
+			b.WriteRune(sparkRunes[0])
+			continue
+		}
+		idx := int(float64(v) / float64(peak) * float64(len(sparkRunes)-1))
+		b.WriteRune(sparkRunes[idx])
+	}
+	return b.String()
+}
+
+// Bar renders value as a horizontal bar scaled so that peak fills width.
+// Non-zero values always get at least one cell.
+func Bar(value int64, peak int64, width int) string {
+	if value <= 0 || peak <= 0 || width <= 0 {
+		return ""
+	}
+	cells := int(float64(value) / float64(peak) * float64(width))
+	if cells == 0 {
+		cells = 1
+	}
+	return strings.Repeat("█", cells)
+}
+
+func renderTopActiveItems(w io.Writer, items []rollbar.TopActiveItem, opts ReportRenderOptions) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if !opts.NoHeaders {
+		if _, err := fmt.Fprintln(tw, "COUNTER\tLEVEL\tENVIRONMENT\tOCCURRENCES\tTREND\tTITLE"); err != nil {
+			return err
+		}
+	}
+	for _, entry := range items {
+		row := []string{
+			strconv.FormatInt(entry.Item.Counter, 10),
+			fallback(entry.Item.Level),
+			fallback(entry.Item.Environment),
+			strconv.FormatInt(entry.Occurrences, 10),
+			fallback(Sparkline(entry.Counts)),
+			fallback(entry.Item.Title),
+		}
+		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func renderCountBuckets(w io.Writer, buckets []rollbar.CountBucket, opts ReportRenderOptions) error {
+	width := opts.BarWidth
+	if width <= 0 {
+		width = defaultReportBarWidth
+	}
+
+	var peak, total int64
+	values := make([]int64, 0, len(buckets))
+	for _, bucket := range buckets {
+		values = append(values, bucket.Count)
+		total += bucket.Count
+		if bucket.Count > peak {
+			peak = bucket.Count
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if !opts.NoHeaders {
+		if _, err := fmt.Fprintln(tw, "TIME\tCOUNT\t"); err != nil {
+			return err
+		}
+	}
+	for _, bucket := range buckets {
+		row := []string{
+			formatUnix(bucket.Timestamp),
+			strconv.FormatInt(bucket.Count, 10),
+			Bar(bucket.Count, peak, width),
+		}
+		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if opts.NoHeaders {
+		return nil
+	}
+	_, err := fmt.Fprintf(w, "\n%s  total=%d peak=%d\n", Sparkline(values), total, peak)
+	return err
+}
+
+func renderBreakdowns(w io.Writer, breakdowns []rollbar.Breakdown, opts ReportRenderOptions) error {
+	width := opts.BarWidth
+	if width <= 0 {
+		width = defaultReportBarWidth
+	}
+
+	for i, breakdown := range breakdowns {
+		if i > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%s (%d occurrences)\n", breakdown.Path, breakdown.Total); err != nil {
+			return err
+		}
+
+		var peak int64
+		for _, value := range breakdown.Values {
+			peak = max(peak, value.Count)
+		}
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		if !opts.NoHeaders {
+			if _, err := fmt.Fprintln(tw, "VALUE\tCOUNT\tPERCENT\t"); err != nil {
+				return err
+			}
+		}
+		for _, value := range breakdown.Values {
+			row := []string{
+				fallback(value.Value),
+				strconv.FormatInt(value.Count, 10),
+				formatPercent(value.Percent),
+				Bar(value.Count, peak, width),
+			}
+			if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+				return err
+			}
+		}
+		if breakdown.Other > 0 {
+			row := []string{"(other)", strconv.FormatInt(breakdown.Other, 10), formatPercent(otherPercent(breakdown)), ""}
+			if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+				return err
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func otherPercent(breakdown rollbar.Breakdown) float64 {
+	if breakdown.Total == 0 {
+		return 0
+	}
+	return math.Round(float64(breakdown.Other)/float64(breakdown.Total)*10000) / 100
+}
+
+func formatPercent(percent float64) string {
+	return strconv.FormatFloat(percent, 'f', 1, 64) + "%"
+}
@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+func TestSparkline(t *testing.T) {
+	if got := Sparkline([]int64{0, 1, 4, 8}); got != "▁▁▄█" {
+		t.Fatalf("Sparkline() = %q", got)
+	}
+	if got := Sparkline([]int64{0, 0}); got != "▁▁" {
+		t.Fatalf("Sparkline() all zero = %q", got)
+	}
+	if got := Sparkline(nil); got != "" {
+		t.Fatalf("Sparkline(nil) = %q", got)
+	}
+}
+
+func TestBar(t *testing.T) {
+	if got := Bar(5, 10, 10); got != "█████" {
+		t.Fatalf("Bar() = %q", got)
+	}
+	if got := Bar(1, 1000, 10); got != "█" {
+		t.Fatalf("Bar() should keep small values visible, got %q", got)
+	}
+	if got := Bar(0, 10, 10); got != "" {
+		t.Fatalf("Bar() zero = %q", got)
+	}
+}
+
+func TestRenderCountBuckets(t *testing.T) {
+	var buf bytes.Buffer
+	err := renderCountBuckets(&buf, []rollbar.CountBucket{
+		{Timestamp: 1700000000, Count: 2},
+		{Timestamp: 1700003600, Count: 4},
+	}, ReportRenderOptions{BarWidth: 4})
+	if err != nil {
+		t.Fatalf("renderCountBuckets() error = %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "TIME") || !strings.Contains(out, "2023-11-14T22:13:20Z  2      ██") || !strings.Contains(out, "total=6 peak=4") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestRenderTopActiveItems(t *testing.T) {
+	var buf bytes.Buffer
+	err := renderTopActiveItems(&buf, []rollbar.TopActiveItem{
+		{Item: rollbar.Item{Counter: 12, Title: "boom", Level: "error"}, Occurrences: 30, Counts: []int64{0, 10, 20}},
+	}, ReportRenderOptions{})
+	if err != nil {
+		t.Fatalf("renderTopActiveItems() error = %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "TREND") || !strings.Contains(out, "▁▄█") || !strings.Contains(out, "boom") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestRenderBreakdowns(t *testing.T) {
+	var buf bytes.Buffer
+	err := renderBreakdowns(&buf, []rollbar.Breakdown{{
+		Path:   "server.host",
+		Total:  8,
+		Values: []rollbar.BreakdownValue{{Value: "web-1", Count: 4, Percent: 50}, {Value: "web-2", Count: 2, Percent: 25}},
+		Other:  2,
+	}}, ReportRenderOptions{BarWidth: 4})
+	if err != nil {
+		t.Fatalf("renderBreakdowns() error = %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"server.host (8 occurrences)", "web-1    4      50.0%    ████", "web-2    2      25.0%    ██", "(other)  2      25.0%"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("missing %q in output:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+type RQLResultRenderOptions struct {
+	NoHeaders bool
+}
+
+type RQLJobRenderOptions struct {
+	Fields    []string
+	NoHeaders bool
+}
+
+var defaultRQLJobListFields = []string{"id", "status", "date_created", "query"}
+
+func RenderRQLResult(result rollbar.RQLResult, opts RQLResultRenderOptions) error {
+	if len(result.Rows) == 0 {
+		_, err := fmt.Fprintln(os.Stdout, "No rows returned.")
+		return err
+	}
+	return renderRQLResult(os.Stdout, result, opts)
+}
+
+func RenderRQLJob(job rollbar.RQLJob) error {
+	return renderRQLJob(os.Stdout, job)
+}
+
+func RenderRQLJobs(jobs []rollbar.RQLJob, opts RQLJobRenderOptions) error {
+	if len(jobs) == 0 {
+		_, err := fmt.Fprintln(os.Stdout, "No RQL jobs found.")
+		return err
+	}
+	return renderRQLJobsPlain(os.Stdout, jobs, opts)
+}
+
+func DefaultRQLJobListFields() []string {
+	return append([]string(nil), defaultRQLJobListFields...)
+}
+
+func renderRQLResult(w io.Writer, result rollbar.RQLResult, opts RQLResultRenderOptions) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if !opts.NoHeaders && len(result.Columns) > 0 {
+		if _, err := fmt.Fprintln(tw, strings.Join(fieldHeaders(result.Columns), "\t")); err != nil {
+			return err
+		}
+	}
+	for _, row := range result.Rows {
+		values := make([]string, 0, len(row))
+		for _, value := range row {
+			values = append(values, FormatRQLValue(value))
+		}
+		if _, err := fmt.Fprintln(tw, strings.Join(values, "\t")); err != nil {
+			return err
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, warning := range result.Warnings {
+		if _, err := fmt.Fprintf(w, "Warning: %s\n", warning); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FormatRQLValue renders a single result cell. Whole numbers are printed
+// without a decimal point since RQL returns every number as a float.
+func FormatRQLValue(value any) string {
+	switch t := value.(type) {
+	case nil:
+		return "-"
+	case string:
+		return fallback(strings.ReplaceAll(t, "\n", " "))
+	case float64:
+		if t == math.Trunc(t) && math.Abs(t) < 1e15 {
+			return strconv.FormatInt(int64(t), 10)
+		}
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	default:
+		encoded, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Sprint(t)
+		}
+		return string(encoded)
+	}
+}
+
+func renderRQLJob(w io.Writer, job rollbar.RQLJob) error {
+	if _, err := fmt.Fprintf(w, "ID: %d\n", job.ID); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Project ID: %d\n", job.ProjectID); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Status: %s\n", fallback(job.Status)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Query: %s\n", fallback(job.QueryString)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Created: %s\n", formatUnix(job.DateCreated)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Modified: %s\n", formatUnix(job.DateModified)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func renderRQLJobsPlain(w io.Writer, jobs []rollbar.RQLJob, opts RQLJobRenderOptions) error {
+	fields := opts.Fields
+	if len(fields) == 0 {
+		fields = defaultRQLJobListFields
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if !opts.NoHeaders {
+		if _, err := fmt.Fprintln(tw, strings.Join(fieldHeaders(fields), "\t")); err != nil {
+			return err
+		}
+	}
+	for _, job := range jobs {
+		if _, err := fmt.Fprintln(tw, strings.Join(rqlJobFieldValues(job, fields), "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func rqlJobFieldValues(job rollbar.RQLJob, fields []string) []string {
+	values := make([]string, 0, len(fields))
+	for _, field := range fields {
+		switch field {
+		case "id", "job_id":
+			values = append(values, strconv.FormatInt(job.ID, 10))
+		case "project_id":
+			values = append(values, strconv.FormatInt(job.ProjectID, 10))
+		case "status":
+			values = append(values, fallback(job.Status))
+		case "query", "query_string":
+			values = append(values, fallback(job.QueryString))
+		case "job_hash":
+			values = append(values, fallback(job.JobHash))
+		case "date_created":
+			values = append(values, formatUnix(job.DateCreated))
+		case "date_modified":
+			values = append(values, formatUnix(job.DateModified))
+		default:
+			values = append(values, "-")
+		}
+	}
+	return values
+}
@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+func TestRenderRQLResult(t *testing.T) {
+	var buf bytes.Buffer
+	err := renderRQLResult(&buf, rollbar.RQLResult{
+		Columns:  []string{"request.url", "count(*)"},
+		Rows:     [][]any{{"/checkout", float64(12)}, {nil, 1.5}},
+		Warnings: []string{"sampled"},
+	}, RQLResultRenderOptions{})
+	if err != nil {
+		t.Fatalf("renderRQLResult() error = %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "REQUEST.URL") || !strings.Contains(out, "/checkout    12") || !strings.Contains(out, "1.5") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	if !strings.Contains(out, "Warning: sampled") {
+		t.Fatalf("expected warnings in output: %q", out)
+	}
+}
+
+func TestFormatRQLValue(t *testing.T) {
+	cases := map[string]any{
+		"-":       nil,
+		"42":      float64(42),
+		"0.5":     0.5,
+		"true":    true,
+		"a b":     "a\nb",
+		`["x",1]`: []any{"x", 1},
+	}
+	for want, value := range cases {
+		if got := FormatRQLValue(value); got != want {
+			t.Fatalf("FormatRQLValue(%#v) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestRenderRQLJobsPlain(t *testing.T) {
+	var buf bytes.Buffer
+	err := renderRQLJobsPlain(&buf, []rollbar.RQLJob{
+		{ID: 77, Status: "success", QueryString: "SELECT 1", DateCreated: 1700000000},
+	}, RQLJobRenderOptions{})
+	if err != nil {
+		t.Fatalf("renderRQLJobsPlain() error = %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "QUERY") || !strings.Contains(out, "SELECT 1") || !strings.Contains(out, "2023-11-14") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
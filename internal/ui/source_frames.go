@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+	"github.com/davebarnwell/rollbar-cli/internal/source"
+)
+
+const (
+	ansiBold = "\x1b[1m"
+	ansiDim  = "\x1b[2m"
+)
+
+// SourceRenderOptions shows each stack frame with the matching lines from a
+// local checkout. A nil Resolver keeps the one-line frame format.
+type SourceRenderOptions struct {
+	Resolver *source.Resolver
+	Color    bool
+}
+
+func renderFrameSource(w io.Writer, frame rollbar.StackFrame, opts SourceRenderOptions) error {
+	library := source.IsLibrary(frame.Filename)
+	kind := "[app]"
+	if library {
+		kind = "[lib]"
+	}
+	header := fmt.Sprintf("    %s %s", kind, formatStackFrame(frame))
+	if opts.Color && library {
+		header = ansiDim + header + ansiReset
+	} else if opts.Color {
+		header = ansiBold + header + ansiReset
+	}
+	if _, err := fmt.Fprintln(w, header); err != nil {
+		return err
+	}
+
+	// Library frames stay on one line; their code is rarely in the checkout
+	// and a basename match would show the wrong file.
+	if library {
+		return nil
+	}
+	snippet, ok := opts.Resolver.Snippet(frame.Filename, int(frame.Line))
+	if !ok {
+		return nil
+	}
+	width := len(strconv.Itoa(snippet.Lines[len(snippet.Lines)-1].Number))
+	for _, line := range snippet.Lines {
+		marker := " "
+		if line.Current {
+			marker = ">"
+		}
+		text := fmt.Sprintf("      %s %*d | %s", marker, width, line.Number, line.Text)
+		if line.Current && snippet.Blame != nil {
+			text += "    # " + formatBlame(*snippet.Blame)
+		}
+		if opts.Color && line.Current {
+			text = ansiRed + text + ansiReset
+		}
+		if _, err := fmt.Fprintln(w, text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatBlame(blame source.Blame) string {
+	commit := blame.Commit
+	if len(commit) > 8 {
+		commit = commit[:8]
+	}
+	text := commit + " " + fallback(blame.Author)
+	if !blame.Time.IsZero() {
+		text += " " + blame.Time.Format("2006-01-02")
+	}
+	return text
+}
@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+	"github.com/davebarnwell/rollbar-cli/internal/source"
+)
+
+func TestRenderItemInstancesWithSource(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "app.py"), []byte("def charge():\n    total = 1\n    raise Boom()\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	instance := rollbar.ItemInstance{ID: 1, StackFrames: []rollbar.StackFrame{
+		{Filename: "/srv/app/app.py", Line: 3, Method: "charge"},
+		{Filename: "/usr/lib/python3.12/site-packages/flask/app.py", Line: 900, Method: "dispatch"},
+	}}
+
+	var buf bytes.Buffer
+	opts := SourceRenderOptions{Resolver: source.NewResolver(source.Options{Root: root, Context: 1})}
+	if err := renderItemInstances(&buf, []rollbar.ItemInstance{instance}, PayloadRenderOptions{Mode: "none"}, opts); err != nil {
+		t.Fatalf("renderItemInstances() error = %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"    [app] /srv/app/app.py:3 (charge)\n        2 |     total = 1\n      > 3 |     raise Boom()\n",
+		"    [lib] /usr/lib/python3.12/site-packages/flask/app.py:900 (dispatch)\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("missing %q in output:\n%s", want, out)
+		}
+	}
+}
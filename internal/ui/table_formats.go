@@ -0,0 +1,364 @@
+package ui
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+const (
+	FormatCSV      = "csv"
+	FormatTSV      = "tsv"
+	FormatYAML     = "yaml"
+	FormatMarkdown = "markdown"
+)
+
+// Table is a list flattened to the same fields and cell values as the text
+// tables, for writing as csv, tsv, yaml, or markdown.
+type Table struct {
+	Fields []string
+	Rows   [][]string
+}
+
+type TableWriteOptions struct {
+	// NoHeaders drops the header row from csv and tsv. Markdown tables need a
+	// header and yaml keys every value, so they ignore it.
+	NoHeaders bool
+}
+
+func ItemsTable(items []rollbar.Item, fields []string) Table {
+	fields = fieldsOrDefault(fields, defaultItemListFields)
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, itemFieldValues(item, fields))
+	}
+	return Table{Fields: fields, Rows: rows}
+}
+
+func OccurrencesTable(occurrences []rollbar.ItemInstance, fields []string) Table {
+	fields = fieldsOrDefault(fields, defaultOccurrenceListFields)
+	rows := make([][]string, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		rows = append(rows, occurrenceFieldValues(occurrence, fields))
+	}
+	return Table{Fields: fields, Rows: rows}
+}
+
+func DeploysTable(deploys []rollbar.Deploy, fields []string) Table {
+	fields = fieldsOrDefault(fields, defaultDeployListFields)
+	rows := make([][]string, 0, len(deploys))
+	for _, deploy := range deploys {
+		rows = append(rows, deployFieldValues(deploy, fields))
+	}
+	return Table{Fields: fields, Rows: rows}
+}
+
+func EnvironmentsTable(environments []rollbar.Environment, fields []string) Table {
+	fields = fieldsOrDefault(fields, defaultEnvironmentListFields)
+	rows := make([][]string, 0, len(environments))
+	for _, environment := range environments {
+		rows = append(rows, environmentFieldValues(environment, fields))
+	}
+	return Table{Fields: fields, Rows: rows}
+}
+
+func UsersTable(users []rollbar.User, fields []string) Table {
+	fields = fieldsOrDefault(fields, defaultUserListFields)
+	rows := make([][]string, 0, len(users))
+	for _, user := range users {
+		rows = append(rows, userFieldValues(user, fields))
+	}
+	return Table{Fields: fields, Rows: rows}
+}
+
+func ProjectsTable(projects []rollbar.Project, fields []string) Table {
+	fields = fieldsOrDefault(fields, defaultProjectListFields)
+	rows := make([][]string, 0, len(projects))
+	for _, project := range projects {
+		rows = append(rows, projectFieldValues(project, fields))
+	}
+	return Table{Fields: fields, Rows: rows}
+}
+
+func TeamsTable(teams []rollbar.Team, fields []string) Table {
+	fields = fieldsOrDefault(fields, defaultTeamListFields)
+	rows := make([][]string, 0, len(teams))
+	for _, team := range teams {
+		rows = append(rows, teamFieldValues(team, fields))
+	}
+	return Table{Fields: fields, Rows: rows}
+}
+
+func ProjectTokensTable(tokens []rollbar.ProjectAccessToken, fields []string, showTokens bool) Table {
+	fields = fieldsOrDefault(fields, defaultProjectTokenListFields)
+	rows := make([][]string, 0, len(tokens))
+	for _, token := range tokens {
+		rows = append(rows, projectTokenFieldValues(token, fields, showTokens))
+	}
+	return Table{Fields: fields, Rows: rows}
+}
+
+func RQLJobsTable(jobs []rollbar.RQLJob, fields []string) Table {
+	fields = fieldsOrDefault(fields, defaultRQLJobListFields)
+	rows := make([][]string, 0, len(jobs))
+	for _, job := range jobs {
+		rows = append(rows, rqlJobFieldValues(job, fields))
+	}
+	return Table{Fields: fields, Rows: rows}
+}
+
+// RQLResultTable names unnamed or missing columns colN, matching the keys of
+// ndjson result rows.
+func RQLResultTable(result rollbar.RQLResult) Table {
+	fields := append([]string(nil), result.Columns...)
+	rows := make([][]string, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		values := make([]string, 0, len(row))
+		for _, value := range row {
+			values = append(values, FormatRQLValue(value))
+		}
+		rows = append(rows, values)
+		for len(fields) < len(row) {
+			fields = append(fields, "")
+		}
+	}
+	for idx, field := range fields {
+		if field == "" {
+			fields[idx] = "col" + strconv.Itoa(idx)
+		}
+	}
+	return Table{Fields: fields, Rows: rows}
+}
+
+func TopActiveItemsTable(items []rollbar.TopActiveItem) Table {
+	rows := make([][]string, 0, len(items))
+	for _, entry := range items {
+		rows = append(rows, []string{
+			strconv.FormatInt(entry.Item.Counter, 10),
+			fallback(entry.Item.Level),
+			fallback(entry.Item.Environment),
+			strconv.FormatInt(entry.Occurrences, 10),
+			fallback(Sparkline(entry.Counts)),
+			fallback(entry.Item.Title),
+		})
+	}
+	return Table{Fields: []string{"counter", "level", "environment", "occurrences", "trend", "title"}, Rows: rows}
+}
+
+func CountBucketsTable(buckets []rollbar.CountBucket) Table {
+	rows := make([][]string, 0, len(buckets))
+	for _, bucket := range buckets {
+		rows = append(rows, []string{formatUnix(bucket.Timestamp), strconv.FormatInt(bucket.Count, 10)})
+	}
+	return Table{Fields: []string{"time", "count"}, Rows: rows}
+}
+
+// BreakdownsTable flattens breakdowns to one row per path and value, with
+// values cut by the top-N limit summed into an "(other)" row.
+func BreakdownsTable(breakdowns []rollbar.Breakdown) Table {
+	rows := make([][]string, 0)
+	for _, breakdown := range breakdowns {
+		for _, value := range breakdown.Values {
+			rows = append(rows, []string{breakdown.Path, value.Value, strconv.FormatInt(value.Count, 10), strconv.FormatFloat(value.Percent, 'f', -1, 64)})
+		}
+		if breakdown.Other > 0 {
+			rows = append(rows, []string{breakdown.Path, "(other)", strconv.FormatInt(breakdown.Other, 10), strconv.FormatFloat(otherPercent(breakdown), 'f', -1, 64)})
+		}
+	}
+	return Table{Fields: []string{"path", "value", "count", "percent"}, Rows: rows}
+}
+
+func WriteTable(w io.Writer, format string, table Table, opts TableWriteOptions) error {
+	switch format {
+	case FormatCSV:
+		return writeCSV(w, table, opts)
+	case FormatTSV:
+		return writeTSV(w, table, opts)
+	case FormatYAML:
+		return writeYAML(w, table)
+	case FormatMarkdown:
+		return writeMarkdown(w, table)
+	default:
+		return fmt.Errorf("unsupported table format %q", format)
+	}
+}
+
+func fieldsOrDefault(fields []string, defaults []string) []string {
+	if len(fields) == 0 {
+		return defaults
+	}
+	return fields
+}
+
+func writeCSV(w io.Writer, table Table, opts TableWriteOptions) error {
+	cw := csv.NewWriter(w)
+	if !opts.NoHeaders {
+		if err := cw.Write(table.Fields); err != nil {
+			return err
+		}
+	}
+	if err := cw.WriteAll(table.Rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// tsvEscaper uses the backslash escapes most TSV readers understand, so a cell
+// can never split a row or a column.
+var tsvEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+
+func writeTSV(w io.Writer, table Table, opts TableWriteOptions) error {
+	write := func(cells []string) error {
+		escaped := make([]string, 0, len(cells))
+		for _, cell := range cells {
+			escaped = append(escaped, tsvEscaper.Replace(cell))
+		}
+		_, err := fmt.Fprintln(w, strings.Join(escaped, "\t"))
+		return err
+	}
+	if !opts.NoHeaders {
+		if err := write(table.Fields); err != nil {
+			return err
+		}
+	}
+	for _, row := range table.Rows {
+		if err := write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeYAML prints a sequence with one mapping per row. Whole numbers stay
+// plain so they load as integers; everything else is double-quoted.
+func writeYAML(w io.Writer, table Table) error {
+	if len(table.Rows) == 0 {
+		_, err := fmt.Fprintln(w, "[]")
+		return err
+	}
+	for _, row := range table.Rows {
+		for i, field := range table.Fields {
+			prefix := "  "
+			if i == 0 {
+				prefix = "- "
+			}
+			value := ""
+			if i < len(row) {
+				value = row[i]
+			}
+			if _, err := fmt.Fprintf(w, "%s%s: %s\n", prefix, yamlKey(field), yamlScalar(value)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// yamlKey leaves identifier-like keys such as field names bare and quotes
+// anything else, like RQL column expressions.
+func yamlKey(key string) string {
+	for i, r := range key {
+		letter := r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+		if !letter && (i == 0 || !(r == '.' || r == '-' || r >= '0' && r <= '9')) {
+			return strconv.Quote(key)
+		}
+	}
+	if key == "" {
+		return `""`
+	}
+	return key
+}
+
+// yamlScalar relies on Go's escapes being a subset of YAML's double-quoted
+// escapes.
+func yamlScalar(value string) string {
+	if isPlainInteger(value) {
+		return value
+	}
+	return strconv.Quote(value)
+}
+
+func isPlainInteger(value string) bool {
+	digits := strings.TrimPrefix(value, "-")
+	if digits == "" || len(digits) > 1 && digits[0] == '0' {
+		return false
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// markdownEscaper keeps cell text literal: pipes would end the cell, and the
+// other characters would otherwise turn into emphasis, links, code, or HTML.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "|", `\|`, "`", "\\`", "*", `\*`, "_", `\_`,
+	"[", `\[`, "]", `\]`, "<", `\<`, ">", `\>`,
+	"\r\n", "<br>", "\n", "<br>", "\r", "<br>",
+)
+
+// writeMarkdown prints a GitHub-flavoured table padded so it also reads well
+// as plain text.
+func writeMarkdown(w io.Writer, table Table) error {
+	header := make([]string, 0, len(table.Fields))
+	for _, field := range fieldHeaders(table.Fields) {
+		header = append(header, markdownEscaper.Replace(field))
+	}
+	rows := make([][]string, 0, len(table.Rows))
+	for _, row := range table.Rows {
+		cells := make([]string, len(header))
+		for i := range cells {
+			if i < len(row) {
+				cells[i] = markdownEscaper.Replace(row[i])
+			}
+		}
+		rows = append(rows, cells)
+	}
+
+	widths := make([]int, len(header))
+	for i, cell := range header {
+		widths[i] = max(3, utf8.RuneCountInString(cell))
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	write := func(cells []string) error {
+		var b strings.Builder
+		b.WriteString("|")
+		for i, cell := range cells {
+			b.WriteString(" ")
+			b.WriteString(cell)
+			b.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)))
+			b.WriteString(" |")
+		}
+		_, err := fmt.Fprintln(w, b.String())
+		return err
+	}
+
+	if err := write(header); err != nil {
+		return err
+	}
+	separator := make([]string, len(widths))
+	for i, width := range widths {
+		separator[i] = strings.Repeat("-", width)
+	}
+	if err := write(separator); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+var awkwardTable = Table{
+	Fields: []string{"id", "title"},
+	Rows: [][]string{
+		{"42", `Error: "quoted", comma`},
+		{"7", "a|b\tc\nsecond line <br> *bold* \\"},
+	},
+}
+
+func TestWriteTableCSVQuotesCells(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteTable(&buf, FormatCSV, awkwardTable, TableWriteOptions{}); err != nil {
+		t.Fatalf("WriteTable() error = %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid csv: %v", err)
+	}
+	if len(records) != 3 || records[0][1] != "title" || records[1][1] != awkwardTable.Rows[0][1] || records[2][1] != awkwardTable.Rows[1][1] {
+		t.Fatalf("csv did not round-trip: %#v", records)
+	}
+}
+
+func TestWriteTableTSVEscapesSeparators(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteTable(&buf, FormatTSV, awkwardTable, TableWriteOptions{NoHeaders: true}); err != nil {
+		t.Fatalf("WriteTable() error = %v", err)
+	}
+
+	want := "42\tError: \"quoted\", comma\n" +
+		"7\ta|b\\tc\\nsecond line <br> *bold* \\\\\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected tsv:\n%q\nwant\n%q", buf.String(), want)
+	}
+}
+
+func TestWriteTableMarkdownEscapesCells(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteTable(&buf, FormatMarkdown, awkwardTable, TableWriteOptions{}); err != nil {
+		t.Fatalf("WriteTable() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected header, separator and two rows, got %q", buf.String())
+	}
+	if !strings.HasPrefix(lines[0], "| ID  | TITLE") || !strings.HasPrefix(lines[1], "| --- | ---") {
+		t.Fatalf("unexpected header: %q", lines[:2])
+	}
+	if !strings.Contains(lines[3], `a\|b`+"\t"+`c<br>second line \<br\> \*bold\* \\`) {
+		t.Fatalf("unexpected escaped row: %q", lines[3])
+	}
+	for _, line := range lines {
+		if len([]rune(line)) != len([]rune(lines[0])) {
+			t.Fatalf("expected padded columns, got %q", buf.String())
+		}
+	}
+}
+
+func TestWriteTableYAML(t *testing.T) {
+	var buf bytes.Buffer
+	table := Table{
+		Fields: []string{"id", "count(*)", "title"},
+		Rows:   [][]string{{"42", "007", "line one\nnull"}},
+	}
+	if err := WriteTable(&buf, FormatYAML, table, TableWriteOptions{}); err != nil {
+		t.Fatalf("WriteTable() error = %v", err)
+	}
+
+	want := "- id: 42\n" +
+		"  \"count(*)\": \"007\"\n" +
+		"  title: \"line one\\nnull\"\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected yaml:\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := WriteTable(&buf, FormatYAML, Table{Fields: []string{"id"}}, TableWriteOptions{}); err != nil {
+		t.Fatalf("WriteTable() error = %v", err)
+	}
+	if buf.String() != "[]\n" {
+		t.Fatalf("expected empty sequence, got %q", buf.String())
+	}
+}
+
+func TestItemsTableUsesListFields(t *testing.T) {
+	items := []rollbar.Item{{ID: 1, Counter: 9, Level: "error", Title: "boom"}}
+
+	table := ItemsTable(items, nil)
+	if strings.Join(table.Fields, ",") != strings.Join(defaultItemListFields, ",") {
+		t.Fatalf("expected default fields, got %v", table.Fields)
+	}
+
+	table = ItemsTable(items, []string{"counter", "title", "nope"})
+	if strings.Join(table.Rows[0], ",") != "9,boom,-" {
+		t.Fatalf("unexpected row: %v", table.Rows[0])
+	}
+}
+
+func TestRQLResultTableNamesMissingColumns(t *testing.T) {
+	table := RQLResultTable(rollbar.RQLResult{
+		Columns: []string{"item.counter"},
+		Rows:    [][]any{{float64(3), "x"}},
+	})
+	if strings.Join(table.Fields, ",") != "item.counter,col1" || strings.Join(table.Rows[0], ",") != "3,x" {
+		t.Fatalf("unexpected table: %#v", table)
+	}
+}
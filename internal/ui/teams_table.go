@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+type TeamRenderOptions struct {
+	Fields    []string
+	NoHeaders bool
+}
+
+var defaultTeamListFields = []string{"id", "name", "access_level"}
+
+func RenderTeam(team rollbar.Team) error {
+	return renderTeam(os.Stdout, team)
+}
+
+func RenderTeams(teams []rollbar.Team) error {
+	return RenderTeamsWithOptions(teams, TeamRenderOptions{})
+}
+
+func RenderTeamsWithOptions(teams []rollbar.Team, opts TeamRenderOptions) error {
+	if len(teams) == 0 {
+		_, err := fmt.Fprintln(os.Stdout, "No teams found.")
+		return err
+	}
+	return renderTeamsPlain(os.Stdout, teams, opts)
+}
+
+func DefaultTeamListFields() []string {
+	return append([]string(nil), defaultTeamListFields...)
+}
+
+func renderTeam(w io.Writer, team rollbar.Team) error {
+	if _, err := fmt.Fprintf(w, "ID: %d\n", team.ID); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Account ID: %d\n", team.AccountID); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Name: %s\n", fallback(team.Name)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Access Level: %s\n", fallback(team.AccessLevel)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func renderTeamsPlain(w io.Writer, teams []rollbar.Team, opts TeamRenderOptions) error {
+	fields := opts.Fields
+	if len(fields) == 0 {
+		fields = defaultTeamListFields
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if !opts.NoHeaders {
+		if _, err := fmt.Fprintln(tw, strings.Join(fieldHeaders(fields), "\t")); err != nil {
+			return err
+		}
+	}
+	for _, team := range teams {
+		if _, err := fmt.Fprintln(tw, strings.Join(teamFieldValues(team, fields), "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func teamFieldValues(team rollbar.Team, fields []string) []string {
+	values := make([]string, 0, len(fields))
+	for _, field := range fields {
+		switch field {
+		case "id", "team_id":
+			values = append(values, strconv.FormatInt(team.ID, 10))
+		case "account_id":
+			values = append(values, strconv.FormatInt(team.AccountID, 10))
+		case "name":
+			values = append(values, fallback(team.Name))
+		case "access_level":
+			values = append(values, fallback(team.AccessLevel))
+		default:
+			values = append(values, "-")
+		}
+	}
+	return values
+}
@@ -0,0 +1,24 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+func TestRenderTeamsPlain(t *testing.T) {
+	var buf bytes.Buffer
+	err := renderTeamsPlain(&buf, []rollbar.Team{
+		{ID: 7, AccountID: 3, Name: "backend", AccessLevel: "standard"},
+	}, TeamRenderOptions{})
+	if err != nil {
+		t.Fatalf("renderTeamsPlain() error = %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "ACCESS_LEVEL") || !strings.Contains(out, "backend") || !strings.Contains(out, "standard") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
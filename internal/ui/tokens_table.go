@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+type ProjectTokenRenderOptions struct {
+	Fields     []string
+	NoHeaders  bool
+	ShowTokens bool
+}
+
+var defaultProjectTokenListFields = []string{"name", "access_token", "status", "scopes", "rate_limit"}
+
+func RenderProjectToken(token rollbar.ProjectAccessToken) error {
+	return renderProjectToken(os.Stdout, token)
+}
+
+func RenderProjectTokensWithOptions(tokens []rollbar.ProjectAccessToken, opts ProjectTokenRenderOptions) error {
+	if len(tokens) == 0 {
+		_, err := fmt.Fprintln(os.Stdout, "No access tokens found.")
+		return err
+	}
+	return renderProjectTokensPlain(os.Stdout, tokens, opts)
+}
+
+func DefaultProjectTokenListFields() []string {
+	return append([]string(nil), defaultProjectTokenListFields...)
+}
+
+// MaskToken keeps the last four characters so tokens can be told apart
+// without printing the secret.
+func MaskToken(token string) string {
+	if len(token) <= 4 {
+		return strings.Repeat("*", len(token))
+	}
+	return strings.Repeat("*", 8) + token[len(token)-4:]
+}
+
+func renderProjectToken(w io.Writer, token rollbar.ProjectAccessToken) error {
+	if _, err := fmt.Fprintf(w, "Name: %s\n", fallback(token.Name)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Access Token: %s\n", fallback(token.AccessToken)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Project ID: %d\n", token.ProjectID); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Status: %s\n", fallback(token.Status)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Scopes: %s\n", fallback(strings.Join(token.Scopes, ","))); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Rate Limit: %s\n", formatRateLimit(token)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func renderProjectTokensPlain(w io.Writer, tokens []rollbar.ProjectAccessToken, opts ProjectTokenRenderOptions) error {
+	fields := opts.Fields
+	if len(fields) == 0 {
+		fields = defaultProjectTokenListFields
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if !opts.NoHeaders {
+		if _, err := fmt.Fprintln(tw, strings.Join(fieldHeaders(fields), "\t")); err != nil {
+			return err
+		}
+	}
+	for _, token := range tokens {
+		if _, err := fmt.Fprintln(tw, strings.Join(projectTokenFieldValues(token, fields, opts.ShowTokens), "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func projectTokenFieldValues(token rollbar.ProjectAccessToken, fields []string, showTokens bool) []string {
+	values := make([]string, 0, len(fields))
+	for _, field := range fields {
+		switch field {
+		case "name":
+			values = append(values, fallback(token.Name))
+		case "access_token", "token":
+			if showTokens {
+				values = append(values, fallback(token.AccessToken))
+			} else {
+				values = append(values, fallback(MaskToken(token.AccessToken)))
+			}
+		case "project_id":
+			values = append(values, strconv.FormatInt(token.ProjectID, 10))
+		case "status":
+			values = append(values, fallback(token.Status))
+		case "scopes":
+			values = append(values, fallback(strings.Join(token.Scopes, ",")))
+		case "rate_limit":
+			values = append(values, formatRateLimit(token))
+		case "date_created":
+			values = append(values, formatUnix(token.DateCreated))
+		case "date_modified":
+			values = append(values, formatUnix(token.DateModified))
+		default:
+			values = append(values, "-")
+		}
+	}
+	return values
+}
+
+func formatRateLimit(token rollbar.ProjectAccessToken) string {
+	if token.RateLimitWindowCount <= 0 || token.RateLimitWindowSize <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%ds", token.RateLimitWindowCount, token.RateLimitWindowSize)
+}
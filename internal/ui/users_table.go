@@ -16,6 +16,8 @@ type UserRenderOptions struct {
 	NoHeaders bool
 }
 
+var defaultUserListFields = []string{"id", "username", "email"}
+
 func RenderUser(user rollbar.User) error {
 	return renderUser(os.Stdout, user)
 }
@@ -48,7 +50,7 @@ func renderUser(w io.Writer, user rollbar.User) error {
 func renderUsersPlain(w io.Writer, users []rollbar.User, opts UserRenderOptions) error {
 	fields := opts.Fields
 	if len(fields) == 0 {
-		fields = []string{"id", "username", "email"}
+		fields = defaultUserListFields
 	}
 	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
 	if !opts.NoHeaders {
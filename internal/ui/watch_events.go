@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+func RenderWatchEvents(events []rollbar.WatchEvent) error {
+	return renderWatchEvents(os.Stdout, events)
+}
+
+func renderWatchEvents(w io.Writer, events []rollbar.WatchEvent) error {
+	for _, event := range events {
+		if _, err := fmt.Fprintf(w, "%s  %-11s  #%d  %s  (%s)\n", formatUnix(event.ObservedAt), event.Type, event.Item.Counter, fallback(event.Item.Title), WatchEventSummary(event)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WatchEventSummary describes what changed, for the text output and desktop
+// notifications.
+func WatchEventSummary(event rollbar.WatchEvent) string {
+	item := event.Item
+	switch event.Type {
+	case rollbar.WatchNew:
+		return fmt.Sprintf("new %s item in %s", fallback(item.Level), fallback(item.Environment))
+	case rollbar.WatchReactivated:
+		return "reactivated, was " + event.PreviousStatus
+	case rollbar.WatchEscalated:
+		return fmt.Sprintf("level %s -> %s", event.PreviousLevel, item.Level)
+	case rollbar.WatchOccurrences:
+		return fmt.Sprintf("+%d occurrences, %d -> %d", event.Delta, event.PreviousOccurrences, item.TotalOccurrences)
+	default:
+		return event.Type
+	}
+}
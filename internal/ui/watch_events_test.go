@@ -0,0 +1,26 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/davebarnwell/rollbar-cli/internal/rollbar"
+)
+
+func TestRenderWatchEvents(t *testing.T) {
+	var buf bytes.Buffer
+	err := renderWatchEvents(&buf, []rollbar.WatchEvent{
+		{Type: rollbar.WatchNew, ObservedAt: 1700000000, Item: rollbar.Item{Counter: 4, Title: "boom", Level: "error", Environment: "production"}},
+		{Type: rollbar.WatchEscalated, ObservedAt: 1700000000, Item: rollbar.Item{Counter: 1, Title: "slow", Level: "error"}, PreviousLevel: "warning"},
+		{Type: rollbar.WatchOccurrences, ObservedAt: 1700000000, Item: rollbar.Item{Counter: 3, Title: "flaky", TotalOccurrences: 60}, PreviousOccurrences: 50, Delta: 10},
+	})
+	if err != nil {
+		t.Fatalf("renderWatchEvents() error = %v", err)
+	}
+	want := "2023-11-14T22:13:20Z  new          #4  boom  (new error item in production)\n" +
+		"2023-11-14T22:13:20Z  escalated    #1  slow  (level warning -> error)\n" +
+		"2023-11-14T22:13:20Z  occurrences  #3  flaky  (+10 occurrences, 50 -> 60)\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected output:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
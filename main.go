@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"os"
 
 	"github.com/davebarnwell/rollbar-cli/cmd"
@@ -9,12 +8,10 @@ import (
 
 func run() int {
 	if err := cmd.Execute(); err != nil {
-		if _, writeErr := fmt.Fprintln(os.Stderr, err); writeErr != nil {
-			return 1
-		}
-		return 1
+		_ = cmd.WriteError(os.Stderr, err)
+		return cmd.ExitCode(err)
 	}
-	return 0
+	return cmd.ExitOK
 }
 
 func main() {
@@ -1,8 +1,12 @@
 package main
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+
+	"github.com/davebarnwell/rollbar-cli/cmd"
 )
 
 func TestRunSuccess(t *testing.T) {
@@ -24,3 +28,19 @@ func TestRunFailure(t *testing.T) {
 		t.Fatalf("expected exit code 1, got %d", code)
 	}
 }
+
+func TestRunMapsAPIErrorsToExitCodes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"err":1,"message":"Item not found"}`))
+	}))
+	defer ts.Close()
+
+	oldArgs := os.Args
+	defer func() { os.Args = oldArgs }()
+
+	os.Args = []string{"rollbar-cli", "items", "get", "42", "--json", "--token", "tok", "--base-url", ts.URL}
+	if code := run(); code != cmd.ExitNotFound {
+		t.Fatalf("expected exit code %d, got %d", cmd.ExitNotFound, code)
+	}
+}